@@ -0,0 +1,294 @@
+package badminton
+
+import "errors"
+
+var (
+	ErrInvalidSide  = errors.New("the side is neither 0 nor 1")
+	ErrMatchOver    = errors.New("the match is already over")
+	ErrMatchNotOver = errors.New("the match is not over yet")
+	ErrNoRallies    = errors.New("no rallies to undo")
+)
+
+// The half of the court that a player serves from
+// or receives in
+type ServiceCourt int
+
+const (
+	RightCourt ServiceCourt = iota
+	LeftCourt  ServiceCourt = iota
+)
+
+// A player on court identified by the side (0 or 1) and
+// the player of the side (always 0 in singles)
+type CourtPlayer struct {
+	Side, Player int
+}
+
+// A LiveScore keeps track of a match rally by rally.
+//
+// It knows the current server and receiver, the service courts,
+// when the interval is due and when the players change ends.
+// The state is derived by replaying the rallies, which allows
+// to undo the last rally at any time.
+//
+// With a handicap (see ScoreSettings.WithHandicap) each set
+// starts at the head start points.
+//
+// In doubles both sides start each set with their player 0 in
+// the right service court. The side that won the previous set
+// serves first.
+type LiveScore struct {
+	Settings ScoreSettings
+	Doubles  bool
+
+	// The side that serves first in the match
+	FirstServer int
+
+	// The winning side of each rally
+	rallies []int
+
+	state liveState
+}
+
+type liveState struct {
+	sets   [][2]int
+	points [2]int
+
+	setWins [2]int
+
+	serving int
+	// The player of each side who stands in the right service court
+	rightPlayer [2]int
+
+	// The end that side 0 plays on (0 or 1)
+	end int
+
+	intervalTaken bool
+
+	// Events of the last rally
+	interval   bool
+	changeEnds bool
+	setEnded   bool
+	over       bool
+}
+
+func NewLiveScore(settings ScoreSettings, doubles bool, firstServer int) (*LiveScore, error) {
+	if firstServer != 0 && firstServer != 1 {
+		return nil, ErrInvalidSide
+	}
+
+	live := &LiveScore{
+		Settings:    settings,
+		Doubles:     doubles,
+		FirstServer: firstServer,
+	}
+	live.replay()
+
+	return live, nil
+}
+
+// Awards the rally to the given side
+func (l *LiveScore) RallyWon(side int) error {
+	if side != 0 && side != 1 {
+		return ErrInvalidSide
+	}
+	if l.state.over {
+		return ErrMatchOver
+	}
+
+	l.rallies = append(l.rallies, side)
+	l.applyRally(side)
+
+	return nil
+}
+
+// Reverts the last rally
+func (l *LiveScore) Undo() error {
+	if len(l.rallies) == 0 {
+		return ErrNoRallies
+	}
+
+	l.rallies = l.rallies[:len(l.rallies)-1]
+	l.replay()
+
+	return nil
+}
+
+// Returns the points of both sides in the current set
+func (l *LiveScore) Points() [2]int {
+	return l.state.points
+}
+
+// Returns the points of the completed sets
+func (l *LiveScore) Sets() [][2]int {
+	sets := make([][2]int, len(l.state.sets))
+	copy(sets, l.state.sets)
+	return sets
+}
+
+// Returns the number of won sets of both sides
+func (l *LiveScore) SetWins() [2]int {
+	return l.state.setWins
+}
+
+// Returns the number of rallies played
+func (l *LiveScore) NumRallies() int {
+	return len(l.rallies)
+}
+
+// Returns the player who serves the next rally
+func (l *LiveScore) Server() CourtPlayer {
+	side := l.state.serving
+	return CourtPlayer{Side: side, Player: l.playerInCourt(side, l.ServiceCourt())}
+}
+
+// Returns the player who receives the next rally
+func (l *LiveScore) Receiver() CourtPlayer {
+	side := 1 - l.state.serving
+	return CourtPlayer{Side: side, Player: l.playerInCourt(side, l.ServiceCourt())}
+}
+
+// Returns the service court of the next rally. The server serves from
+// the right court when their side's points are even.
+func (l *LiveScore) ServiceCourt() ServiceCourt {
+	if l.state.points[l.state.serving]%2 == 0 {
+		return RightCourt
+	}
+	return LeftCourt
+}
+
+// Returns the service court that the player stands in
+func (l *LiveScore) CourtOf(player CourtPlayer) ServiceCourt {
+	if !l.Doubles {
+		if l.state.points[l.state.serving]%2 == 0 {
+			return RightCourt
+		}
+		return LeftCourt
+	}
+	if l.state.rightPlayer[player.Side] == player.Player {
+		return RightCourt
+	}
+	return LeftCourt
+}
+
+// Returns the end (0 or 1) that side 0 plays on.
+// Side 1 plays on the other end.
+func (l *LiveScore) End() int {
+	return l.state.end
+}
+
+// Returns true when the last rally started the interval
+// of the current set (the leading side reached the
+// middle of the winning points, e.g. 11 of 21)
+func (l *LiveScore) IsInterval() bool {
+	return l.state.interval
+}
+
+// Returns true when the players change ends after the last rally.
+// This happens after each set and at the interval of the deciding set.
+func (l *LiveScore) ChangeEnds() bool {
+	return l.state.changeEnds
+}
+
+// Returns true when the last rally ended a set
+func (l *LiveScore) SetEnded() bool {
+	return l.state.setEnded
+}
+
+// Returns true when one side won the match
+func (l *LiveScore) IsOver() bool {
+	return l.state.over
+}
+
+// Returns the final score once the match is over
+func (l *LiveScore) Score() (*Score, error) {
+	if !l.state.over {
+		return nil, ErrMatchNotOver
+	}
+
+	a := make([]int, 0, len(l.state.sets))
+	b := make([]int, 0, len(l.state.sets))
+	for _, set := range l.state.sets {
+		a = append(a, set[0])
+		b = append(b, set[1])
+	}
+
+	return NewScore(a, b, l.Settings)
+}
+
+func (l *LiveScore) playerInCourt(side int, court ServiceCourt) int {
+	if !l.Doubles {
+		return 0
+	}
+	rightPlayer := l.state.rightPlayer[side]
+	if court == RightCourt {
+		return rightPlayer
+	}
+	return 1 - rightPlayer
+}
+
+func (l *LiveScore) replay() {
+	l.state = liveState{serving: l.FirstServer, points: l.Settings.Handicap}
+	for _, side := range l.rallies {
+		l.applyRally(side)
+	}
+}
+
+func (l *LiveScore) applyRally(side int) {
+	s := &l.state
+	s.interval, s.changeEnds, s.setEnded = false, false, false
+
+	s.points[side] += 1
+	if side == s.serving {
+		// The server switches service courts with their partner
+		s.rightPlayer[side] = 1 - s.rightPlayer[side]
+	} else {
+		s.serving = side
+	}
+
+	if l.isSetOver() {
+		s.sets = append(s.sets, s.points)
+		s.setWins[side] += 1
+		s.setEnded = true
+
+		if s.setWins[side] == l.Settings.WinningSets {
+			s.over = true
+			return
+		}
+
+		s.points = l.Settings.Handicap
+		s.rightPlayer = [2]int{}
+		s.intervalTaken = false
+		s.changeEnds = true
+		s.end = 1 - s.end
+		return
+	}
+
+	intervalPoints := (l.Settings.WinningPoints + 1) / 2
+	if !s.intervalTaken && max(s.points[0], s.points[1]) == intervalPoints {
+		s.intervalTaken = true
+		s.interval = true
+
+		decidingSet := s.setWins[0] == l.Settings.WinningSets-1 && s.setWins[1] == l.Settings.WinningSets-1
+		if decidingSet {
+			s.changeEnds = true
+			s.end = 1 - s.end
+		}
+	}
+}
+
+func (l *LiveScore) isSetOver() bool {
+	w := max(l.state.points[0], l.state.points[1])
+	lo := min(l.state.points[0], l.state.points[1])
+
+	switch {
+	case w >= l.Settings.MaxPoints:
+		return true
+	case w < l.Settings.WinningPoints:
+		return false
+	case !l.Settings.TwoPointMargin:
+		return true
+	}
+
+	return w-lo >= 2
+}
@@ -0,0 +1,175 @@
+package badminton
+
+import (
+	"reflect"
+	"testing"
+)
+
+func winRallies(l *LiveScore, side, n int) {
+	for range n {
+		l.RallyWon(side)
+	}
+}
+
+func TestLiveScoreService(t *testing.T) {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+
+	_, err := NewLiveScore(settings, false, 2)
+	if err != ErrInvalidSide {
+		t.Fatal("invalid first server did not error")
+	}
+
+	live, _ := NewLiveScore(settings, false, 0)
+	if live.Server() != (CourtPlayer{0, 0}) || live.ServiceCourt() != RightCourt {
+		t.Fatal("the first server does not serve from the right court")
+	}
+
+	live.RallyWon(0)
+	if live.Server().Side != 0 || live.ServiceCourt() != LeftCourt {
+		t.Fatal("the server did not serve from the left court at an odd score")
+	}
+
+	live.RallyWon(1)
+	eq1 := live.Server().Side == 1 && live.Receiver().Side == 0
+	eq2 := live.ServiceCourt() == LeftCourt && live.Points() == [2]int{1, 1}
+	if !eq1 || !eq2 {
+		t.Fatal("the service did not change to the rally winner")
+	}
+
+	live.Undo()
+	if live.Server().Side != 0 || live.Points() != [2]int{1, 0} {
+		t.Fatal("the last rally was not undone")
+	}
+
+	live.Undo()
+	err = live.Undo()
+	if err != ErrNoRallies || live.NumRallies() != 0 {
+		t.Fatal("undo without rallies did not error")
+	}
+}
+
+func TestLiveScoreDoubles(t *testing.T) {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+	live, _ := NewLiveScore(settings, true, 0)
+
+	live.RallyWon(0)
+	server := live.Server()
+	eq1 := server == CourtPlayer{0, 0} && live.CourtOf(server) == LeftCourt
+	eq2 := live.CourtOf(CourtPlayer{0, 1}) == RightCourt
+	if !eq1 || !eq2 {
+		t.Fatal("the server did not switch service courts with their partner")
+	}
+
+	live.RallyWon(1)
+	server = live.Server()
+	receiver := live.Receiver()
+	eq1 = server == CourtPlayer{1, 1} && live.CourtOf(server) == LeftCourt
+	eq2 = receiver == CourtPlayer{0, 0} && live.CourtOf(receiver) == LeftCourt
+	if !eq1 || !eq2 {
+		t.Fatal("the service did not pass to the player in the left court")
+	}
+
+	live.RallyWon(1)
+	if live.Server() != (CourtPlayer{1, 1}) || live.ServiceCourt() != RightCourt {
+		t.Fatal("the server did not serve from the right court at an even score")
+	}
+}
+
+func TestLiveScoreSets(t *testing.T) {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+	live, _ := NewLiveScore(settings, false, 0)
+
+	winRallies(live, 0, 10)
+	if live.IsInterval() {
+		t.Fatal("the interval started too early")
+	}
+	live.RallyWon(0)
+	if !live.IsInterval() || live.ChangeEnds() {
+		t.Fatal("the interval did not start at 11 or the ends changed")
+	}
+
+	winRallies(live, 0, 10)
+	eq1 := live.SetEnded() && live.ChangeEnds() && live.End() == 1
+	eq2 := live.Points() == [2]int{0, 0} && live.Server().Side == 0
+	if !eq1 || !eq2 {
+		t.Fatal("the set did not end at the winning points")
+	}
+
+	winRallies(live, 1, 11)
+	winRallies(live, 0, 10)
+	for range 9 {
+		live.RallyWon(0)
+		live.RallyWon(1)
+	}
+	live.RallyWon(0)
+	live.RallyWon(1)
+	if live.SetEnded() || live.Points() != [2]int{20, 21} {
+		t.Fatal("the set ended without a two point margin")
+	}
+	live.RallyWon(1)
+	if !live.SetEnded() || live.SetWins() != [2]int{1, 1} {
+		t.Fatal("the set did not end with a two point margin")
+	}
+
+	winRallies(live, 0, 10)
+	if live.ChangeEnds() {
+		t.Fatal("the ends changed before the interval of the deciding set")
+	}
+	live.RallyWon(1)
+	winRallies(live, 1, 10)
+	if !live.IsInterval() || !live.ChangeEnds() {
+		t.Fatal("the ends did not change at the interval of the deciding set")
+	}
+
+	_, err := live.Score()
+	if err != ErrMatchNotOver {
+		t.Fatal("the score of an unfinished match did not error")
+	}
+
+	live.RallyWon(0)
+	for range 18 {
+		live.RallyWon(0)
+		live.RallyWon(1)
+	}
+	if live.IsOver() || live.Points() != [2]int{29, 29} {
+		t.Fatal("the match ended before the max points")
+	}
+	live.RallyWon(1)
+	if !live.IsOver() {
+		t.Fatal("the match did not end at the max points")
+	}
+
+	err = live.RallyWon(0)
+	if err != ErrMatchOver {
+		t.Fatal("a rally after the end of the match did not error")
+	}
+
+	score, err := live.Score()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	eq1 = reflect.DeepEqual(score.Points1(), []int{21, 20, 29})
+	eq2 = reflect.DeepEqual(score.Points2(), []int{0, 22, 30})
+	if !eq1 || !eq2 {
+		t.Fatal("the live score did not produce the played score")
+	}
+
+	live.Undo()
+	if live.IsOver() || live.Points() != [2]int{29, 29} {
+		t.Fatal("undoing the last rally did not reopen the match")
+	}
+}
+
+func TestLiveScoreHandicap(t *testing.T) {
+	settings, _ := BWF21x3.WithHandicap(0, 6)
+	live, _ := NewLiveScore(settings, false, 0)
+
+	if live.Points() != [2]int{0, 6} {
+		t.Fatal("the set did not start at the head start")
+	}
+
+	winRallies(live, 1, 15)
+	if !live.SetEnded() || live.Points() != [2]int{0, 6} {
+		t.Fatal("the next set did not start at the head start")
+	}
+}
@@ -0,0 +1,189 @@
+package badminton
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ezBadminton/gotournament/core"
+)
+
+var (
+	ErrSetFormat        = errors.New("the set is not in the format 21-15 or 21:15")
+	ErrAnnotationFormat = errors.New("the annotation is not at the end of the score")
+	ErrWalkoverPoints   = errors.New("a walkover can not have a score")
+)
+
+// The annotation of a score that did not end regularly
+type Annotation int
+
+const (
+	// The match was played to the end
+	Completed Annotation = iota
+	// A player retired during the match ("ret.")
+	Retired Annotation = iota
+	// A player did not show up to the match ("w/o")
+	Walkover Annotation = iota
+)
+
+// A ParsedScore is the result of parsing a score string
+type ParsedScore struct {
+	// The validated score. It is a partial score (see NewPartialScore)
+	// when the match was retired and nil when it was a walkover.
+	Score *Score
+
+	Annotation Annotation
+}
+
+// Returns the score in the format "21-15 18-21 21-19".
+// Retired scores are followed by "ret." and walkovers are "w/o".
+func (p ParsedScore) String() string {
+	switch p.Annotation {
+	case Walkover:
+		return "w/o"
+	case Retired:
+		if p.Score == nil {
+			return "ret."
+		}
+		return FormatScore(p.Score) + " ret."
+	}
+	return FormatScore(p.Score)
+}
+
+// A ParseError is returned when a score string can
+// not be parsed or the parsed score is invalid
+type ParseError struct {
+	// The index of the offending set or -1 when the
+	// error concerns the score as a whole
+	// (e.g. when the score has too few sets)
+	Set int
+	// The text of the offending set
+	Text string
+
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	if e.Set == -1 {
+		return fmt.Sprintf("invalid score: %v", e.Err)
+	}
+	return fmt.Sprintf("invalid set %d %q: %v", e.Set+1, e.Text, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var setSeparatorSpaces = regexp.MustCompile(`\s*([-:])\s*`)
+
+// Parses a score string like "21-15 18-21 21-19" or "21:15, 18:21, 21:19"
+// into a score that is validated according to the settings.
+//
+// The points of the first opponent come first in each set. The sets are
+// separated by whitespace or commas. The score can end in the annotation
+// "ret." when a player retired during the match. Then the last set may
+// be unfinished. A walkover is written as "w/o" without any sets.
+//
+// All errors are of the type *ParseError.
+func ParseScore(text string, settings ScoreSettings) (ParsedScore, error) {
+	normalized := strings.ReplaceAll(text, ",", " ")
+	normalized = setSeparatorSpaces.ReplaceAllString(normalized, "$1")
+	tokens := strings.Fields(normalized)
+
+	annotation := Completed
+	if len(tokens) > 0 {
+		annotation = parseAnnotation(tokens[len(tokens)-1])
+		if annotation != Completed {
+			tokens = tokens[:len(tokens)-1]
+		}
+	}
+
+	if annotation == Walkover {
+		if len(tokens) > 0 {
+			return ParsedScore{}, &ParseError{Set: 0, Text: tokens[0], Err: ErrWalkoverPoints}
+		}
+		return ParsedScore{Annotation: Walkover}, nil
+	}
+
+	if annotation == Retired && len(tokens) == 0 {
+		return ParsedScore{Annotation: Retired}, nil
+	}
+
+	a := make([]int, 0, len(tokens))
+	b := make([]int, 0, len(tokens))
+	for i, token := range tokens {
+		if parseAnnotation(token) != Completed {
+			return ParsedScore{}, &ParseError{Set: i, Text: token, Err: ErrAnnotationFormat}
+		}
+
+		points1, points2, err := parseSet(token)
+		if err != nil {
+			return ParsedScore{}, &ParseError{Set: i, Text: token, Err: err}
+		}
+		a = append(a, points1)
+		b = append(b, points2)
+	}
+
+	var score *Score
+	var err error
+	if annotation == Retired {
+		score, err = NewPartialScore(a, b, settings)
+	} else {
+		score, err = NewScore(a, b, settings)
+	}
+
+	if err != nil {
+		parseErr := &ParseError{Set: -1, Err: err}
+		if _, _, invalidSet, setErr := validateSets(a, b, settings); setErr == err {
+			parseErr.Set = invalidSet
+			parseErr.Text = tokens[invalidSet]
+		}
+		return ParsedScore{}, parseErr
+	}
+
+	return ParsedScore{Score: score, Annotation: annotation}, nil
+}
+
+func parseAnnotation(token string) Annotation {
+	switch strings.ToLower(token) {
+	case "ret.", "ret":
+		return Retired
+	case "w/o", "wo":
+		return Walkover
+	}
+	return Completed
+}
+
+func parseSet(token string) (int, int, error) {
+	points := strings.FieldsFunc(token, func(r rune) bool { return r == '-' || r == ':' })
+	if len(points) != 2 || strings.Count(token, "-")+strings.Count(token, ":") != 1 {
+		return 0, 0, ErrSetFormat
+	}
+
+	points1, err1 := strconv.Atoi(points[0])
+	points2, err2 := strconv.Atoi(points[1])
+	if err1 != nil || err2 != nil {
+		return 0, 0, ErrSetFormat
+	}
+
+	return points1, points2, nil
+}
+
+// Formats the score in the format "21-15 18-21 21-19"
+func FormatScore(score core.Score) string {
+	points1 := score.Points1()
+	points2 := score.Points2()
+
+	sets := make([]string, 0, len(points1))
+	for i := range len(points1) {
+		sets = append(sets, fmt.Sprintf("%d-%d", points1[i], points2[i]))
+	}
+
+	return strings.Join(sets, " ")
+}
+
+func (s *Score) String() string {
+	return FormatScore(s)
+}
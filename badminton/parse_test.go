@@ -0,0 +1,117 @@
+package badminton
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseScore(t *testing.T) {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+
+	parsed, err := ParseScore("21-15 18-21 21-19", settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	eq1 := reflect.DeepEqual(parsed.Score.Points1(), []int{21, 18, 21})
+	eq2 := reflect.DeepEqual(parsed.Score.Points2(), []int{15, 21, 19})
+	if !eq1 || !eq2 || parsed.Annotation != Completed {
+		t.Fatal("the score was not parsed")
+	}
+
+	other, err := ParseScore(" 21:15,18 : 21,  21:19 ", settings)
+	if err != nil || !reflect.DeepEqual(other.Score, parsed.Score) {
+		t.Fatal("the score with colons and commas was not parsed")
+	}
+
+	if parsed.String() != "21-15 18-21 21-19" {
+		t.Fatal("the score was not formatted")
+	}
+
+	retired, err := ParseScore("21-15 11-15 ret.", settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	eq1 = retired.Annotation == Retired && retired.Score.Points2()[1] == 15
+	eq2 = retired.String() == "21-15 11-15 ret."
+	if !eq1 || !eq2 {
+		t.Fatal("the retired score was not parsed")
+	}
+
+	walkover, err := ParseScore("W/O", settings)
+	if err != nil || walkover.Annotation != Walkover || walkover.Score != nil {
+		t.Fatal("the walkover was not parsed")
+	}
+	if walkover.String() != "w/o" {
+		t.Fatal("the walkover was not formatted")
+	}
+}
+
+func TestParseScoreErrors(t *testing.T) {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+
+	tests := []struct {
+		text string
+		set  int
+		err  error
+	}{
+		{"21-15 18-21 21/19", 2, ErrSetFormat},
+		{"21-15 x-21", 1, ErrSetFormat},
+		{"21-15-3 21-19", 0, ErrSetFormat},
+		{"21-15 ret. 21-19", 1, ErrAnnotationFormat},
+		{"21-15 w/o", 0, ErrWalkoverPoints},
+		{"21-15 18-21 20-18", 2, ErrTooFewPoints},
+		{"21-15 31-29", 1, ErrTooManyPoints},
+		{"21-15 21-18 21-19", 2, ErrUnneededSets},
+		{"21-15", -1, ErrTooFewSets},
+		{"", -1, ErrEmpty},
+		{"21-15 21-19 ret.", -1, ErrCompleteScore},
+		{"21-15 25-21 ret.", 1, ErrInvalidMargin},
+	}
+
+	for _, test := range tests {
+		_, err := ParseScore(test.text, settings)
+
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("%q did not return a parse error", test.text)
+		}
+		if parseErr.Set != test.set || !errors.Is(err, test.err) {
+			t.Fatalf("%q returned the wrong error: %v", test.text, err)
+		}
+	}
+}
+
+func TestPartialScore(t *testing.T) {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+
+	_, err := NewPartialScore([]int{21, 15}, []int{15, 11}, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = NewPartialScore([]int{21, 0}, []int{15, 0}, settings)
+	if err != nil {
+		t.Fatal("a retirement at the start of a set did error")
+	}
+
+	_, err = NewPartialScore([]int{21, 25}, []int{15, 24}, settings)
+	if err != nil {
+		t.Fatal("an unfinished set in the extension did error")
+	}
+
+	_, err = NewPartialScore([]int{21}, []int{15}, settings)
+	if err != nil {
+		t.Fatal("a retirement between sets did error")
+	}
+
+	_, err = NewPartialScore([]int{21, 21}, []int{15, 11}, settings)
+	if err != ErrCompleteScore {
+		t.Fatal("a complete score was accepted as partial")
+	}
+
+	_, err = NewPartialScore([]int{15, 21}, []int{11, 15}, settings)
+	if err != ErrTooFewPoints {
+		t.Fatal("an unfinished set before the last set was accepted")
+	}
+}
@@ -0,0 +1,209 @@
+package badminton
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+)
+
+var (
+	ErrUnknownPreset   = errors.New("the scoring system preset is unknown")
+	ErrInvalidSettings = errors.New("the score settings are missing fields or have invalid types")
+)
+
+// The MaxPoints of scoring systems where a set continues
+// until one side has a two point lead
+const Uncapped = math.MaxInt
+
+// Common scoring systems
+var (
+	// The standard BWF scoring: best of 3 sets to 21 points
+	// with a two point margin up to 30 points
+	BWF21x3 = ScoreSettings{WinningPoints: 21, WinningSets: 2, MaxPoints: 30, TwoPointMargin: true}
+	// The experimental BWF scoring: best of 3 sets to 15 points
+	// with a two point margin up to 21 points
+	BWF15x3 = ScoreSettings{WinningPoints: 15, WinningSets: 2, MaxPoints: 21, TwoPointMargin: true}
+	// The experimental BWF scoring: best of 5 sets to 11 points
+	// with a two point margin up to 15 points
+	BWF11x5 = ScoreSettings{WinningPoints: 11, WinningSets: 3, MaxPoints: 15, TwoPointMargin: true}
+	// A single set to 31 points with a two point margin
+	// up to 40 points
+	Single31 = ScoreSettings{WinningPoints: 31, WinningSets: 1, MaxPoints: 40, TwoPointMargin: true}
+	// Best of 3 sets to 6 games where a set at 6-6
+	// is decided by a tie-break (7-6)
+	TennisLike = ScoreSettings{WinningPoints: 6, WinningSets: 2, MaxPoints: 7, TwoPointMargin: true}
+	// Best of 5 sets to 11 points where a set continues
+	// until one side leads by two points
+	TableTennisLike = ScoreSettings{WinningPoints: 11, WinningSets: 3, MaxPoints: Uncapped, TwoPointMargin: true}
+)
+
+var presets = map[string]ScoreSettings{
+	"21x3":        BWF21x3,
+	"15x3":        BWF15x3,
+	"11x5":        BWF11x5,
+	"31x1":        Single31,
+	"tennis":      TennisLike,
+	"tabletennis": TableTennisLike,
+}
+
+// Returns the score settings of the scoring system preset with the given name
+func Preset(name string) (ScoreSettings, error) {
+	settings, ok := presets[name]
+	if !ok {
+		return ScoreSettings{}, ErrUnknownPreset
+	}
+	return settings, nil
+}
+
+// Returns the names of all scoring system presets in alphabetical order
+func PresetNames() []string {
+	return slices.Sorted(maps.Keys(presets))
+}
+
+// Returns the name of the preset that has the same settings
+// or "" if the settings are not a preset
+func (s ScoreSettings) PresetName() string {
+	for name, preset := range presets {
+		if preset == s {
+			return name
+		}
+	}
+	return ""
+}
+
+// Returns the settings as a map that can be stored as part of
+// a tournament configuration. Presets also store their name.
+// The MaxPoints of uncapped settings are nil and the handicap
+// is only stored when it is set.
+func (s ScoreSettings) ToMap() map[string]any {
+	result := map[string]any{
+		"winningPoints":  s.WinningPoints,
+		"winningSets":    s.WinningSets,
+		"maxPoints":      s.MaxPoints,
+		"twoPointMargin": s.TwoPointMargin,
+	}
+	if s.MaxPoints == Uncapped {
+		result["maxPoints"] = nil
+	}
+	if s.Handicap != [2]int{} {
+		result["handicap"] = []int{s.Handicap[0], s.Handicap[1]}
+		if name := s.withoutHandicap().PresetName(); name != "" {
+			result["preset"] = name
+		}
+	} else if name := s.PresetName(); name != "" {
+		result["preset"] = name
+	}
+	return result
+}
+
+func (s ScoreSettings) withoutHandicap() ScoreSettings {
+	s.Handicap = [2]int{}
+	return s
+}
+
+// Reads score settings from a map in the format of ToMap.
+//
+// When the map has a "preset" name the settings of the preset are used.
+// Otherwise the settings are read from the fields and validated like in
+// NewScoreSettings. A handicap is applied with WithHandicap.
+func ScoreSettingsFromMap(m map[string]any) (ScoreSettings, error) {
+	settings, err := scoreSettingsFromMap(m)
+	if err != nil || m["handicap"] == nil {
+		return settings, err
+	}
+
+	handicap, ok := toInts(m["handicap"])
+	if !ok || len(handicap) != 2 {
+		return ScoreSettings{}, ErrInvalidSettings
+	}
+
+	return settings.WithHandicap(handicap[0], handicap[1])
+}
+
+func scoreSettingsFromMap(m map[string]any) (ScoreSettings, error) {
+	if name, ok := m["preset"].(string); ok {
+		return Preset(name)
+	}
+
+	winningPoints, ok1 := mapInt(m, "winningPoints")
+	winningSets, ok2 := mapInt(m, "winningSets")
+	twoPointMargin, ok3 := m["twoPointMargin"].(bool)
+	if !ok1 || !ok2 || !ok3 {
+		return ScoreSettings{}, ErrInvalidSettings
+	}
+
+	maxPoints := Uncapped
+	if m["maxPoints"] != nil {
+		points, ok := mapInt(m, "maxPoints")
+		if !ok {
+			return ScoreSettings{}, ErrInvalidSettings
+		}
+		maxPoints = points
+	}
+
+	return NewScoreSettings(winningPoints, winningSets, maxPoints, twoPointMargin)
+}
+
+// Reads an integer from the map
+func mapInt(m map[string]any, key string) (int, bool) {
+	return toInt(m[key])
+}
+
+// Converts the value to an integer. Numbers decoded
+// from JSON are float64 and are converted.
+func toInt(v any) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, false
+		}
+		return int(v), true
+	}
+	return 0, false
+}
+
+// Converts the value to a slice of integers. Slices
+// decoded from JSON are []any and are converted.
+func toInts(v any) ([]int, bool) {
+	if ints, ok := v.([]int); ok {
+		return ints, true
+	}
+
+	values, ok := v.([]any)
+	if !ok {
+		return nil, false
+	}
+
+	ints := make([]int, 0, len(values))
+	for _, value := range values {
+		i, ok := toInt(value)
+		if !ok {
+			return nil, false
+		}
+		ints = append(ints, i)
+	}
+	return ints, true
+}
+
+func (s ScoreSettings) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToMap())
+}
+
+func (s *ScoreSettings) UnmarshalJSON(data []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	settings, err := ScoreSettingsFromMap(m)
+	if err != nil {
+		return err
+	}
+
+	*s = settings
+	return nil
+}
@@ -0,0 +1,101 @@
+package badminton
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPresets(t *testing.T) {
+	for _, name := range PresetNames() {
+		preset, err := Preset(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = NewScoreSettings(preset.WinningPoints, preset.WinningSets, preset.MaxPoints, preset.TwoPointMargin)
+		if err != nil {
+			t.Fatalf("the preset %v is invalid: %v", name, err)
+		}
+		if preset.PresetName() != name {
+			t.Fatalf("the preset %v did not return its name", name)
+		}
+	}
+
+	_, err := Preset("unknown")
+	if err != ErrUnknownPreset {
+		t.Fatal("an unknown preset did not error")
+	}
+
+	custom, _ := NewScoreSettings(21, 1, 21, false)
+	if custom.PresetName() != "" {
+		t.Fatal("custom settings returned a preset name")
+	}
+}
+
+func TestUncappedScore(t *testing.T) {
+	_, err := NewScore([]int{11, 11, 35}, []int{3, 5, 33}, TableTennisLike)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = NewScore([]int{11, 11, 35}, []int{3, 5, 32}, TableTennisLike)
+	if err != ErrInvalidMargin {
+		t.Fatal("an uncapped set without a two point margin did not error")
+	}
+}
+
+func TestScoreSettingsSerialization(t *testing.T) {
+	mixed := map[string]ScoreSettings{
+		"MS": BWF21x3,
+		"XD": TableTennisLike,
+		"JU": {WinningPoints: 15, WinningSets: 1, MaxPoints: 15},
+	}
+
+	data, err := json.Marshal(mixed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[string]ScoreSettings
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for event, settings := range mixed {
+		if decoded[event] != settings {
+			t.Fatalf("the settings of %v were not restored", event)
+		}
+	}
+
+	m := TableTennisLike.ToMap()
+	if m["preset"] != "tabletennis" || m["maxPoints"] != nil {
+		t.Fatal("the uncapped preset was not marshalled")
+	}
+
+	_, err = ScoreSettingsFromMap(map[string]any{"winningPoints": 21.5, "winningSets": 2.0, "twoPointMargin": true})
+	if err != ErrInvalidSettings {
+		t.Fatal("invalid settings did not error")
+	}
+
+	_, err = ScoreSettingsFromMap(map[string]any{"winningPoints": 21.0, "winningSets": 2.0, "maxPoints": 20.0, "twoPointMargin": true})
+	if err != ErrMaxPoints {
+		t.Fatal("the decoded settings were not validated")
+	}
+}
+
+func TestHandicapSerialization(t *testing.T) {
+	settings, _ := BWF21x3.WithHandicap(6, 0)
+
+	m := settings.ToMap()
+	if m["preset"] != "21x3" || m["handicap"] == nil {
+		t.Fatal("the handicap settings were not marshalled")
+	}
+
+	data, _ := json.Marshal(settings)
+	var decoded ScoreSettings
+	err := json.Unmarshal(data, &decoded)
+	if err != nil || decoded != settings {
+		t.Fatal("the handicap settings were not restored")
+	}
+}
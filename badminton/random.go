@@ -0,0 +1,57 @@
+package badminton
+
+import "math/rand"
+
+// Creates a random but valid score according to the settings.
+// The winner is 0 when the first opponent should win and 1
+// when the second opponent should win.
+//
+// The loser wins a random number of sets and each set is won
+// with exactly the winning points unless the handicap of the
+// loser forces an extended set. This is meant for simulations
+// (see core.SimulationSettings) where only plausible results
+// are required.
+func RandomScore(settings ScoreSettings, winner int, rng *rand.Rand) *Score {
+	winningMargin := 1
+	if settings.TwoPointMargin {
+		winningMargin = 2
+	}
+	maxLoserPoints := max(0, settings.WinningPoints-winningMargin)
+
+	loserSetWins := rng.Intn(settings.WinningSets)
+	numSets := settings.WinningSets + loserSetWins
+
+	// The winner always wins the last set. The
+	// loser's set wins are distributed before it.
+	setWinners := make([]int, numSets)
+	for i := range loserSetWins {
+		setWinners[i] = 1
+	}
+	rng.Shuffle(numSets-1, func(i, j int) {
+		setWinners[i], setWinners[j] = setWinners[j], setWinners[i]
+	})
+
+	a := make([]int, numSets)
+	b := make([]int, numSets)
+	for i, setWinner := range setWinners {
+		if winner == 1 {
+			setWinner = 1 - setWinner
+		}
+
+		// The loser starts at their handicap. A loser who starts
+		// close to the winning points forces an extended set.
+		loserStart := settings.Handicap[1-setWinner]
+		loserPoints := loserStart + rng.Intn(max(0, maxLoserPoints-loserStart)+1)
+		winnerPoints := max(settings.WinningPoints, min(loserPoints+winningMargin, settings.MaxPoints))
+
+		if setWinner == 0 {
+			a[i] = winnerPoints
+			b[i] = loserPoints
+		} else {
+			a[i] = loserPoints
+			b[i] = winnerPoints
+		}
+	}
+
+	return &Score{a: a, b: b, handicap: settings.Handicap}
+}
@@ -24,11 +24,23 @@ var (
 	ErrInvalidMargin   = errors.New("the winning point margin is invalid")
 	ErrUnneededSets    = errors.New("score contains unneeded extra sets")
 	ErrEqualSetWins    = errors.New("both opponents won an equal number of sets")
+	ErrNoDraw          = errors.New("the opponents did not win an equal number of sets")
+	ErrCompleteScore   = errors.New("the partial score already has a winner")
+	ErrBelowHandicap   = errors.New("points are less than the handicap head start")
+
+	ErrHandicap = errors.New("the handicap is negative or not less than the winning points")
+
+	ErrTimedTie = errors.New("a timed match can not end in a tie, a golden point decides it")
 )
 
 type ScoreSettings struct {
 	WinningPoints, WinningSets, MaxPoints int
 	TwoPointMargin                        bool
+
+	// The points that the opponents start each set with.
+	// A weaker opponent gets a head start (e.g. {6, 0}).
+	// See WithHandicap.
+	Handicap [2]int
 }
 
 func NewScoreSettings(
@@ -40,7 +52,10 @@ func NewScoreSettings(
 	}
 
 	scoreSettings := ScoreSettings{
-		winningPoints, winningSets, maxPoints, twoPointMargin,
+		WinningPoints:  winningPoints,
+		WinningSets:    winningSets,
+		MaxPoints:      maxPoints,
+		TwoPointMargin: twoPointMargin,
 	}
 
 	if winningPoints <= 0 {
@@ -56,10 +71,30 @@ func NewScoreSettings(
 	return scoreSettings, nil
 }
 
-var _ core.Score = (*Score)(nil)
+// Returns a copy of the settings where the opponents start
+// each set with the given points. The scores of the sets
+// include the head start.
+func (s ScoreSettings) WithHandicap(handicap1, handicap2 int) (ScoreSettings, error) {
+	for _, h := range []int{handicap1, handicap2} {
+		if h < 0 || h >= s.WinningPoints {
+			return s, ErrHandicap
+		}
+	}
+
+	s.Handicap = [2]int{handicap1, handicap2}
+	return s, nil
+}
+
+var (
+	_ core.DrawableScore = (*Score)(nil)
+	_ core.HandicapScore = (*Score)(nil)
+)
 
 type Score struct {
 	a, b []int
+
+	// The points that the opponents started each set with
+	handicap [2]int
 }
 
 func (s *Score) Points1() []int {
@@ -70,6 +105,11 @@ func (s *Score) Points2() []int {
 	return s.b
 }
 
+// Returns the head start points of both opponents in each set
+func (s *Score) Handicap() (int, int) {
+	return s.handicap[0], s.handicap[1]
+}
+
 func (s *Score) GetWinner() (int, error) {
 	setWins := 0
 	for i := range len(s.a) {
@@ -91,10 +131,23 @@ func (s *Score) GetWinner() (int, error) {
 	return -1, ErrUndetermined
 }
 
+// Returns true when both opponents won the same number of sets.
+// Only scores created by NewDrawScore or NewPartialScore can
+// have level set wins. The match of a level partial score is not
+// a draw when it was terminated (see [core.Match.IsDraw]).
+func (s *Score) IsDraw() bool {
+	if len(s.a) == 0 {
+		return false
+	}
+	_, err := s.GetWinner()
+	return err != nil
+}
+
 func (s *Score) Invert() core.Score {
 	score := &Score{
-		a: s.b,
-		b: s.a,
+		a:        s.b,
+		b:        s.a,
+		handicap: [2]int{s.handicap[1], s.handicap[0]},
 	}
 	return score
 }
@@ -114,6 +167,119 @@ func NewScore(
 		return nil, ErrTooManySets
 	}
 
+	setWinsA, setWinsB, _, err := validateSets(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if setWinsA == setWinsB {
+		return nil, ErrEqualSetWins
+	}
+
+	return &Score{a: a, b: b, handicap: settings.Handicap}, nil
+}
+
+// Creates a score where both opponents won the same number
+// of sets. This is used in formats that allow draws
+// (e.g. team leagues where always two sets are played).
+//
+// Each set is validated like in NewScore but neither opponent
+// can have reached the winning sets.
+func NewDrawScore(
+	a, b []int,
+	settings ScoreSettings,
+) (*Score, error) {
+	switch {
+	case len(a) == 0 || len(b) == 0:
+		return nil, ErrEmpty
+	case len(a) != len(b):
+		return nil, ErrUnequalSets
+	case len(a) >= 2*settings.WinningSets:
+		return nil, ErrTooManySets
+	}
+
+	setWinsA, setWinsB, _, err := validateSets(a, b, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if setWinsA != setWinsB {
+		return nil, ErrNoDraw
+	}
+
+	return &Score{a: a, b: b, handicap: settings.Handicap}, nil
+}
+
+// Creates the score of a match that was not played to the end
+// (e.g. because a player retired or was disqualified).
+//
+// The completed sets are validated like in NewScore. The last set
+// may be unfinished. Neither opponent can have won the match.
+func NewPartialScore(
+	a, b []int,
+	settings ScoreSettings,
+) (*Score, error) {
+	switch {
+	case len(a) == 0 || len(b) == 0:
+		return nil, ErrEmpty
+	case len(a) != len(b):
+		return nil, ErrUnequalSets
+	case len(a) >= 2*settings.WinningSets:
+		return nil, ErrTooManySets
+	}
+
+	setWinsA, setWinsB, invalidSet, err := validateSets(a, b, settings)
+	last := len(a) - 1
+	if err != nil && (invalidSet != last || !isUnfinishedSet(a[last], b[last], settings)) {
+		return nil, err
+	}
+
+	if setWinsA == settings.WinningSets || setWinsB == settings.WinningSets {
+		return nil, ErrCompleteScore
+	}
+
+	return &Score{a: a, b: b, handicap: settings.Handicap}, nil
+}
+
+// Creates the score of a timed match (see core.Match.TimeLimit).
+//
+// The score is a single set with the points at the time-out.
+// The points are not validated against the ScoreSettings because
+// the leader wins when the time is up. A tie at the time-out has
+// to be decided by a golden point before the score is created.
+func NewTimedScore(a, b int) (*Score, error) {
+	switch {
+	case a < 0 || b < 0:
+		return nil, ErrNegativePoints
+	case a == b:
+		return nil, ErrTimedTie
+	}
+
+	return &Score{a: []int{a}, b: []int{b}}, nil
+}
+
+// Returns true when the points are a valid state
+// of a set that is still in progress
+func isUnfinishedSet(a, b int, settings ScoreSettings) bool {
+	w := max(a, b)
+	l := min(a, b)
+
+	switch {
+	case a < settings.Handicap[0] || b < settings.Handicap[1]:
+		return false
+	case w < settings.WinningPoints:
+		return true
+	case !settings.TwoPointMargin || w >= settings.MaxPoints:
+		return false
+	}
+
+	return w-l < 2
+}
+
+// Validates the points of each set and returns the number
+// of sets that each opponent won.
+// On error the index of the invalid set is returned.
+func validateSets(a, b []int, settings ScoreSettings) (int, int, int, error) {
 	winningMargin := 1
 	if settings.TwoPointMargin {
 		winningMargin = 2
@@ -126,19 +292,23 @@ func NewScore(
 
 		switch {
 		case setWinsA == settings.WinningSets || setWinsB == settings.WinningSets:
-			return nil, ErrUnneededSets
+			return setWinsA, setWinsB, i, ErrUnneededSets
 		case w == l:
-			return nil, ErrUndeterminedSet
+			return setWinsA, setWinsB, i, ErrUndeterminedSet
 		case l < 0:
-			return nil, ErrNegativePoints
+			return setWinsA, setWinsB, i, ErrNegativePoints
+		case a[i] < settings.Handicap[0] || b[i] < settings.Handicap[1]:
+			return setWinsA, setWinsB, i, ErrBelowHandicap
 		case w < settings.WinningPoints:
-			return nil, ErrTooFewPoints
+			return setWinsA, setWinsB, i, ErrTooFewPoints
 		case w > settings.MaxPoints:
-			return nil, ErrTooManyPoints
+			return setWinsA, setWinsB, i, ErrTooManyPoints
+		case w == settings.WinningPoints && w < settings.MaxPoints && w-l < winningMargin:
+			fallthrough
 		case w < settings.MaxPoints && w > settings.WinningPoints && w-l != winningMargin:
 			fallthrough
 		case w == settings.MaxPoints && w > settings.WinningPoints && w-l > winningMargin:
-			return nil, ErrInvalidMargin
+			return setWinsA, setWinsB, i, ErrInvalidMargin
 		}
 
 		if a[i] > b[i] {
@@ -148,11 +318,7 @@ func NewScore(
 		}
 	}
 
-	if setWinsA == setWinsB {
-		return nil, ErrEqualSetWins
-	}
-
-	return &Score{a, b}, nil
+	return setWinsA, setWinsB, -1, nil
 }
 
 func MaxScore(settings ScoreSettings) *Score {
@@ -160,6 +326,7 @@ func MaxScore(settings ScoreSettings) *Score {
 	b := make([]int, settings.WinningSets)
 	for i := range settings.WinningSets {
 		a[i] = settings.WinningPoints
+		b[i] = settings.Handicap[1]
 	}
-	return &Score{a, b}
+	return &Score{a: a, b: b, handicap: settings.Handicap}
 }
@@ -1,8 +1,11 @@
 package badminton
 
 import (
+	"math/rand"
 	"reflect"
 	"testing"
+
+	"github.com/ezBadminton/gotournament/core"
 )
 
 func TestScoreSettings(t *testing.T) {
@@ -143,6 +146,21 @@ func TestScoreErrors(t *testing.T) {
 		t.Fatal("invalid winning margin did not error")
 	}
 
+	a = []int{21, 21}
+	b = []int{20, 18}
+	_, err = NewScore(a, b, settings)
+	if err != ErrInvalidMargin {
+		t.Fatal("a one point margin at the winning points did not error")
+	}
+
+	cappedSettings, _ := NewScoreSettings(21, 2, 21, true)
+	a = []int{21, 21}
+	b = []int{20, 15}
+	_, err = NewScore(a, b, cappedSettings)
+	if err != nil {
+		t.Fatal("a one point margin at the capped winning points errored")
+	}
+
 	a = []int{21, 7}
 	b = []int{7, 21}
 	_, err = NewScore(a, b, settings)
@@ -242,3 +260,159 @@ func TestMaxScore(t *testing.T) {
 		t.Fatal("max score is incorrect")
 	}
 }
+
+func TestRandomScore(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	handicapSettings, _ := BWF21x3.WithHandicap(20, 6)
+
+	allSettings := []ScoreSettings{
+		{WinningPoints: 21, WinningSets: 2, MaxPoints: 30, TwoPointMargin: true},
+		{WinningPoints: 15, WinningSets: 3, MaxPoints: 15},
+		{WinningPoints: 11, WinningSets: 3, MaxPoints: 15, TwoPointMargin: true},
+		handicapSettings,
+	}
+
+	for _, settings := range allSettings {
+		for winner := range 2 {
+			for range 50 {
+				score := RandomScore(settings, winner, rng)
+				_, err := NewScore(score.a, score.b, settings)
+				if err != nil {
+					t.Fatalf("random score is invalid: %v", err)
+				}
+				scoreWinner, _ := score.GetWinner()
+				if scoreWinner != winner {
+					t.Fatal("random score has the wrong winner")
+				}
+			}
+		}
+	}
+}
+
+func TestDrawScore(t *testing.T) {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+
+	score, err := NewDrawScore([]int{21, 15}, []int{18, 21}, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !score.IsDraw() {
+		t.Fatal("the drawn score is not a draw")
+	}
+	if _, err := score.GetWinner(); err != ErrUndetermined {
+		t.Fatal("the drawn score returned a winner")
+	}
+
+	_, err = NewDrawScore([]int{21, 21}, []int{18, 15}, settings)
+	if err != ErrNoDraw {
+		t.Fatal("a score with a winner was accepted as a draw")
+	}
+
+	_, err = NewDrawScore([]int{21, 19}, []int{18, 15}, settings)
+	if err != ErrTooFewPoints {
+		t.Fatal("the sets of a draw were not validated")
+	}
+
+	score, _ = NewScore([]int{21, 21}, []int{18, 15}, settings)
+	if score.IsDraw() {
+		t.Fatal("a score with a winner is a draw")
+	}
+}
+
+func TestHandicapScore(t *testing.T) {
+	_, err := BWF21x3.WithHandicap(-1, 0)
+	if err != ErrHandicap {
+		t.Fatal("a negative handicap did not error")
+	}
+
+	_, err = BWF21x3.WithHandicap(0, 21)
+	if err != ErrHandicap {
+		t.Fatal("a handicap of the winning points did not error")
+	}
+
+	settings, _ := BWF21x3.WithHandicap(6, 0)
+
+	_, err = NewScore([]int{21, 5}, []int{15, 21}, settings)
+	if err != ErrBelowHandicap {
+		t.Fatal("points below the head start did not error")
+	}
+
+	score, err := NewScore([]int{21, 21}, []int{15, 19}, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	h1, h2 := score.Invert().(*Score).Handicap()
+	if h1 != 0 || h2 != 6 {
+		t.Fatal("the handicap was not inverted with the score")
+	}
+
+	settings, _ = BWF21x3.WithHandicap(0, 6)
+	maxScore := MaxScore(settings)
+	_, err = NewScore(maxScore.a, maxScore.b, settings)
+	if err != nil || maxScore.b[0] != 6 {
+		t.Fatal("the max score does not start at the head start")
+	}
+}
+
+func TestHandicapMetrics(t *testing.T) {
+	players := testPlayers("A", "B")
+	tournament, _ := core.NewRoundRobin(core.NewConstantRanking(players), 1, MaxScore(BWF21x3))
+
+	match := tournament.Matches[0]
+	handicap := [2]int{0, 6}
+	if match.Slot1.Player == players[1] {
+		handicap = [2]int{6, 0}
+	}
+	settings, _ := BWF21x3.WithHandicap(handicap[0], handicap[1])
+
+	// A beats B who started both sets with 6 points
+	a := []int{21, 21}
+	b := []int{15, 10}
+	if handicap[0] != 0 {
+		a, b = b, a
+	}
+	score, err := NewScore(a, b, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	match.StartMatch()
+	match.EndMatch(score)
+	tournament.Update(nil)
+
+	metrics := tournament.FinalRanking.Metrics[players[1]]
+	if metrics.PointWins != 25 || metrics.PointLosses != 42 {
+		t.Fatal("the gross points were not counted")
+	}
+
+	tournament.UseMetricSettings(core.MetricSettings{NetHandicap: true})
+
+	metrics = tournament.FinalRanking.Metrics[players[1]]
+	eq1 := metrics.PointWins == 13 && metrics.PointLosses == 42
+	eq2 := metrics.SetLosses == 2 && tournament.FinalRanking.Metrics[players[0]].PointLosses == 13
+	if !eq1 || !eq2 {
+		t.Fatal("the points were not counted net of the handicap")
+	}
+}
+
+func TestTimedScore(t *testing.T) {
+	_, err := NewTimedScore(14, 14)
+	if err != ErrTimedTie {
+		t.Fatal("a tied timed score did not error")
+	}
+
+	_, err = NewTimedScore(-1, 3)
+	if err != ErrNegativePoints {
+		t.Fatal("negative points did not error")
+	}
+
+	score, err := NewTimedScore(9, 14)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	winner, _ := score.GetWinner()
+	if winner != 1 || score.String() != "9-14" {
+		t.Fatal("the leader at the time-out did not win")
+	}
+}
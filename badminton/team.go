@@ -0,0 +1,295 @@
+package badminton
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/ezBadminton/gotournament/core"
+)
+
+var (
+	ErrNoRubbers           = errors.New("the team tie settings have no rubbers")
+	ErrRubberPlayersZero   = errors.New("a rubber has zero or less players per team")
+	ErrLineupSize          = errors.New("the lineup does not match the rubbers of the tie")
+	ErrDuplicatePlayer     = errors.New("a player appears twice in the same rubber")
+	ErrTooManyRubbers      = errors.New("a player is lined up for too many rubbers")
+	ErrTooManyRubberScores = errors.New("more rubber scores than rubbers")
+	ErrTooFewRubberScores  = errors.New("the tie is not decided by the rubber scores")
+	ErrUnneededRubbers     = errors.New("rubbers were played after the tie was decided")
+	ErrMissingRubberScore  = errors.New("a rubber has no score")
+)
+
+// The format of one individual match in a team tie
+type RubberSettings struct {
+	// The name of the rubber (e.g. "MS1" or "WD")
+	Name string
+
+	// The number of players that each team lines up
+	// for this rubber (1 for singles, 2 for doubles)
+	NumPlayers int
+}
+
+// The format of a team tie (e.g. 3 singles and 2 doubles)
+type TeamTieSettings struct {
+	// The rubbers of a tie in the order of play
+	Rubbers []RubberSettings
+
+	// The score settings that each rubber is played with
+	ScoreSettings ScoreSettings
+
+	// When true the tie ends as soon as one team won the
+	// majority of the rubbers and the remaining rubbers
+	// are not played.
+	EarlyTermination bool
+
+	// The maximum number of rubbers that a player
+	// can be lined up for in one tie. 0 means no limit.
+	MaxRubbersPerPlayer int
+}
+
+func NewTeamTieSettings(
+	rubbers []RubberSettings,
+	scoreSettings ScoreSettings,
+	earlyTermination bool,
+	maxRubbersPerPlayer int,
+) (TeamTieSettings, error) {
+	settings := TeamTieSettings{
+		rubbers, scoreSettings, earlyTermination, maxRubbersPerPlayer,
+	}
+
+	if len(rubbers) == 0 {
+		return settings, ErrNoRubbers
+	}
+	for _, r := range rubbers {
+		if r.NumPlayers <= 0 {
+			return settings, ErrRubberPlayersZero
+		}
+	}
+
+	return settings, nil
+}
+
+// Returns the number of rubber wins that decide a tie
+func (s *TeamTieSettings) DecidingRubberWins() int {
+	return len(s.Rubbers)/2 + 1
+}
+
+// The players of one team for each rubber of a tie.
+// The first index is the rubber.
+type Lineup [][]core.Player
+
+// One individual match of a team tie
+type Rubber struct {
+	Name string
+
+	// The players that the teams lined up for this rubber
+	Lineup1, Lineup2 []core.Player
+
+	// Is nil when the rubber was not played because
+	// the tie was already decided
+	Score *Score
+}
+
+var (
+	_ core.CompositeScore = (*TeamTie)(nil)
+	_ core.DrawableScore  = (*TeamTie)(nil)
+)
+
+// A TeamTie is the score of a match between two teams that
+// consists of multiple rubbers. The team that wins more rubbers
+// wins the tie.
+//
+// As a core.Score the points of a tie are the number of rubbers
+// that each team won. The match metrics count the sets and points
+// of the rubbers (see core.CompositeScore).
+type TeamTie struct {
+	Rubbers []*Rubber
+}
+
+// Returns the number of rubbers that each team won
+func (t *TeamTie) RubberWins() (int, int) {
+	wins1, wins2 := 0, 0
+	for _, r := range t.Rubbers {
+		if r.Score == nil {
+			continue
+		}
+		winner, err := r.Score.GetWinner()
+		switch {
+		case err != nil:
+		case winner == 0:
+			wins1 += 1
+		default:
+			wins2 += 1
+		}
+	}
+	return wins1, wins2
+}
+
+func (t *TeamTie) Points1() []int {
+	wins1, _ := t.RubberWins()
+	return []int{wins1}
+}
+
+func (t *TeamTie) Points2() []int {
+	_, wins2 := t.RubberWins()
+	return []int{wins2}
+}
+
+func (t *TeamTie) GetWinner() (int, error) {
+	wins1, wins2 := t.RubberWins()
+	switch {
+	case wins1 > wins2:
+		return 0, nil
+	case wins2 > wins1:
+		return 1, nil
+	}
+	return -1, ErrUndetermined
+}
+
+// Returns true when both teams won the same number of rubbers.
+// This is only possible with an even number of rubbers.
+func (t *TeamTie) IsDraw() bool {
+	if len(t.Components()) == 0 {
+		return false
+	}
+	_, err := t.GetWinner()
+	return err != nil
+}
+
+func (t *TeamTie) Components() []core.Score {
+	components := make([]core.Score, 0, len(t.Rubbers))
+	for _, r := range t.Rubbers {
+		if r.Score != nil {
+			components = append(components, r.Score)
+		}
+	}
+	return components
+}
+
+func (t *TeamTie) Invert() core.Score {
+	rubbers := make([]*Rubber, 0, len(t.Rubbers))
+	for _, r := range t.Rubbers {
+		inverted := &Rubber{
+			Name:    r.Name,
+			Lineup1: r.Lineup2,
+			Lineup2: r.Lineup1,
+		}
+		if r.Score != nil {
+			inverted.Score = r.Score.Invert().(*Score)
+		}
+		rubbers = append(rubbers, inverted)
+	}
+	return &TeamTie{Rubbers: rubbers}
+}
+
+// Creates a team tie from the lineups of the teams and the scores
+// of the rubbers in the order of play. The scores are expected
+// to be created with the ScoreSettings of the tie settings.
+//
+// With EarlyTermination the scores end with the rubber that decided
+// the tie. Otherwise all rubbers need a score.
+func NewTeamTie(
+	lineup1, lineup2 Lineup,
+	scores []*Score,
+	settings TeamTieSettings,
+) (*TeamTie, error) {
+	if err := validateLineup(lineup1, settings); err != nil {
+		return nil, err
+	}
+	if err := validateLineup(lineup2, settings); err != nil {
+		return nil, err
+	}
+
+	if len(scores) > len(settings.Rubbers) {
+		return nil, ErrTooManyRubberScores
+	}
+
+	decidingWins := settings.DecidingRubberWins()
+	wins1, wins2 := 0, 0
+	for _, score := range scores {
+		if score == nil {
+			return nil, ErrMissingRubberScore
+		}
+		if settings.EarlyTermination && max(wins1, wins2) >= decidingWins {
+			return nil, ErrUnneededRubbers
+		}
+		winner, err := score.GetWinner()
+		if err != nil {
+			return nil, err
+		}
+		if winner == 0 {
+			wins1 += 1
+		} else {
+			wins2 += 1
+		}
+	}
+
+	decided := max(wins1, wins2) >= decidingWins
+	if !decided && len(scores) < len(settings.Rubbers) {
+		return nil, ErrTooFewRubberScores
+	}
+	if !settings.EarlyTermination && len(scores) < len(settings.Rubbers) {
+		return nil, ErrTooFewRubberScores
+	}
+
+	tie := createTeamTie(settings)
+	for i, r := range tie.Rubbers {
+		r.Lineup1 = lineup1[i]
+		r.Lineup2 = lineup2[i]
+		if i < len(scores) {
+			r.Score = scores[i]
+		}
+	}
+
+	return tie, nil
+}
+
+func validateLineup(lineup Lineup, settings TeamTieSettings) error {
+	if len(lineup) != len(settings.Rubbers) {
+		return ErrLineupSize
+	}
+
+	numRubbers := make(map[core.Player]int)
+	for i, players := range lineup {
+		if len(players) != settings.Rubbers[i].NumPlayers {
+			return ErrLineupSize
+		}
+		for j, p := range players {
+			if slices.Contains(players[:j], p) {
+				return ErrDuplicatePlayer
+			}
+			numRubbers[p] += 1
+			if settings.MaxRubbersPerPlayer > 0 && numRubbers[p] > settings.MaxRubbersPerPlayer {
+				return ErrTooManyRubbers
+			}
+		}
+	}
+
+	return nil
+}
+
+func createTeamTie(settings TeamTieSettings) *TeamTie {
+	rubbers := make([]*Rubber, 0, len(settings.Rubbers))
+	for _, r := range settings.Rubbers {
+		rubbers = append(rubbers, &Rubber{Name: r.Name})
+	}
+	return &TeamTie{Rubbers: rubbers}
+}
+
+// Returns the tie that the first team wins by walkover.
+// It wins the deciding rubbers (or all rubbers without
+// EarlyTermination) with the MaxScore. The rubbers have
+// no lineups. Settings without rubbers give a tie
+// without rubbers.
+func MaxTeamTie(settings TeamTieSettings) *TeamTie {
+	numWins := len(settings.Rubbers)
+	if settings.EarlyTermination {
+		numWins = min(numWins, settings.DecidingRubberWins())
+	}
+
+	tie := createTeamTie(settings)
+	for _, r := range tie.Rubbers[:numWins] {
+		r.Score = MaxScore(settings.ScoreSettings)
+	}
+	return tie
+}
@@ -0,0 +1,235 @@
+package badminton
+
+import (
+	"testing"
+
+	"github.com/ezBadminton/gotournament/core"
+)
+
+type testPlayer struct {
+	id string
+}
+
+func (p *testPlayer) Id() string {
+	return p.id
+}
+
+func testPlayers(ids ...string) []core.Player {
+	players := make([]core.Player, 0, len(ids))
+	for _, id := range ids {
+		players = append(players, &testPlayer{id: id})
+	}
+	return players
+}
+
+// Returns settings of 3 singles and 2 doubles
+func testTieSettings(earlyTermination bool) TeamTieSettings {
+	scoreSettings, _ := NewScoreSettings(21, 2, 30, true)
+	rubbers := []RubberSettings{
+		{"MS1", 1}, {"MS2", 1}, {"MS3", 1}, {"MD1", 2}, {"MD2", 2},
+	}
+	settings, _ := NewTeamTieSettings(rubbers, scoreSettings, earlyTermination, 2)
+	return settings
+}
+
+// Returns a valid lineup of the 4 players for the test tie settings
+func testLineup(p []core.Player) Lineup {
+	return Lineup{
+		{p[0]}, {p[1]}, {p[2]}, {p[0], p[1]}, {p[2], p[3]},
+	}
+}
+
+func testRubberScore(winner int) *Score {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+	score, _ := NewScore([]int{21, 21}, []int{10, 15}, settings)
+	if winner == 1 {
+		return score.Invert().(*Score)
+	}
+	return score
+}
+
+func testThreeSetRubberScore(winner int) *Score {
+	settings, _ := NewScoreSettings(21, 2, 30, true)
+	score, _ := NewScore([]int{21, 10, 21}, []int{10, 21, 10}, settings)
+	if winner == 1 {
+		return score.Invert().(*Score)
+	}
+	return score
+}
+
+func TestTeamTieSettings(t *testing.T) {
+	scoreSettings, _ := NewScoreSettings(21, 2, 30, true)
+
+	_, err := NewTeamTieSettings(nil, scoreSettings, true, 0)
+	if err != ErrNoRubbers {
+		t.Fatal("settings without rubbers did not error")
+	}
+
+	_, err = NewTeamTieSettings([]RubberSettings{{"MS", 0}}, scoreSettings, true, 0)
+	if err != ErrRubberPlayersZero {
+		t.Fatal("rubber without players did not error")
+	}
+
+	settings := testTieSettings(true)
+	if settings.DecidingRubberWins() != 3 {
+		t.Fatal("the deciding rubber wins are not the majority")
+	}
+}
+
+func TestTeamTieLineups(t *testing.T) {
+	settings := testTieSettings(true)
+	team1 := testPlayers("a", "b", "c", "d")
+	team2 := testPlayers("e", "f", "g", "h")
+	scores := []*Score{testRubberScore(0), testRubberScore(0), testRubberScore(0)}
+
+	_, err := NewTeamTie(testLineup(team1)[:4], testLineup(team2), scores, settings)
+	if err != ErrLineupSize {
+		t.Fatal("lineup with missing rubber did not error")
+	}
+
+	lineup := testLineup(team1)
+	lineup[3] = []core.Player{team1[0]}
+	_, err = NewTeamTie(lineup, testLineup(team2), scores, settings)
+	if err != ErrLineupSize {
+		t.Fatal("lineup with wrong number of players did not error")
+	}
+
+	lineup = testLineup(team1)
+	lineup[3] = []core.Player{team1[3], team1[3]}
+	_, err = NewTeamTie(lineup, testLineup(team2), scores, settings)
+	if err != ErrDuplicatePlayer {
+		t.Fatal("player twice in the same rubber did not error")
+	}
+
+	lineup = testLineup(team1)
+	lineup[4] = []core.Player{team1[0], team1[3]}
+	_, err = NewTeamTie(lineup, testLineup(team2), scores, settings)
+	if err != ErrTooManyRubbers {
+		t.Fatal("player in too many rubbers did not error")
+	}
+
+	_, err = NewTeamTie(testLineup(team1), testLineup(team2), scores, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTeamTieScores(t *testing.T) {
+	team1 := testPlayers("a", "b", "c", "d")
+	team2 := testPlayers("e", "f", "g", "h")
+	lineup1 := testLineup(team1)
+	lineup2 := testLineup(team2)
+
+	settings := testTieSettings(true)
+
+	scores := []*Score{testRubberScore(0), testRubberScore(1), testRubberScore(0)}
+	_, err := NewTeamTie(lineup1, lineup2, scores, settings)
+	if err != ErrTooFewRubberScores {
+		t.Fatal("undecided tie did not error")
+	}
+
+	scores = []*Score{testRubberScore(0), testRubberScore(0), testRubberScore(0), testRubberScore(1)}
+	_, err = NewTeamTie(lineup1, lineup2, scores, settings)
+	if err != ErrUnneededRubbers {
+		t.Fatal("rubber after the decision did not error with early termination")
+	}
+
+	scores = []*Score{testRubberScore(0), testRubberScore(1), nil}
+	_, err = NewTeamTie(lineup1, lineup2, scores, settings)
+	if err != ErrMissingRubberScore {
+		t.Fatal("missing rubber score did not error")
+	}
+
+	scores = []*Score{testRubberScore(0), testRubberScore(1), testRubberScore(1), testRubberScore(1)}
+	tie, err := NewTeamTie(lineup1, lineup2, scores, settings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	winner, _ := tie.GetWinner()
+	eq1 := winner == 1 && tie.Points1()[0] == 1 && tie.Points2()[0] == 3
+	eq2 := len(tie.Components()) == 4 && tie.Rubbers[4].Score == nil
+	if !eq1 || !eq2 {
+		t.Fatal("the tie was not won by the majority of rubbers")
+	}
+
+	inverted := tie.Invert().(*TeamTie)
+	winner, _ = inverted.GetWinner()
+	if winner != 0 || inverted.Rubbers[0].Lineup1[0] != team2[0] {
+		t.Fatal("the inverted tie did not swap the teams")
+	}
+
+	settings = testTieSettings(false)
+	_, err = NewTeamTie(lineup1, lineup2, scores, settings)
+	if err != ErrTooFewRubberScores {
+		t.Fatal("decided tie without all rubbers did not error without early termination")
+	}
+
+	walkover := MaxTeamTie(settings)
+	if walkover.Points1()[0] != 5 {
+		t.Fatal("the walkover tie does not win all rubbers without early termination")
+	}
+
+	empty := MaxTeamTie(TeamTieSettings{EarlyTermination: true})
+	if len(empty.Rubbers) != 0 {
+		t.Fatal("the walkover tie of settings without rubbers has rubbers")
+	}
+}
+
+func TestTeamTieMetrics(t *testing.T) {
+	settings := testTieSettings(true)
+	teams := testPlayers("A", "B", "C")
+	members := testPlayers("a", "b", "c", "d")
+	lineup := testLineup(members)
+
+	walkoverScore := MaxTeamTie(settings)
+	tournament, _ := core.NewRoundRobin(core.NewConstantRanking(teams), 1, walkoverScore)
+
+	// Every team wins one tie. The rubber difference ranks A before
+	// C before B while the set difference would rank B before C.
+	w := testRubberScore(0)
+	l := testThreeSetRubberScore(1)
+	results := map[[2]core.Player][]*Score{
+		{teams[0], teams[1]}: {w, w, w},
+		{teams[1], teams[2]}: {w, w, l, l, w},
+		{teams[0], teams[2]}: {w, w, l, l, l},
+	}
+
+	for _, m := range tournament.Matches {
+		if m.HasBye() {
+			continue
+		}
+		scores := results[[2]core.Player{m.Slot1.Player, m.Slot2.Player}]
+		inverted := scores == nil
+		if inverted {
+			scores = results[[2]core.Player{m.Slot2.Player, m.Slot1.Player}]
+		}
+
+		tie, err := NewTeamTie(lineup, lineup, scores, settings)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		m.StartMatch()
+		if inverted {
+			m.EndMatch(tie.Invert())
+		} else {
+			m.EndMatch(tie)
+		}
+	}
+	tournament.Update(nil)
+
+	metrics := tournament.FinalRanking.Metrics[teams[0]]
+	eq1 := metrics.Wins == 1 && metrics.NumRubbers == 8
+	eq2 := metrics.RubberWins == 5 && metrics.RubberLosses == 3
+	eq3 := metrics.NumSets == 19 && metrics.SetWins == 13
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("the rubbers of the team ties were not counted in the metrics")
+	}
+
+	ranks := tournament.FinalRanking.Ranks()
+	eq1 = ranks[0].Player == teams[0]
+	eq2 = ranks[1].Player == teams[2] && ranks[2].Player == teams[1]
+	if !eq1 || !eq2 {
+		t.Fatal("the tied teams were not ranked by their rubber difference")
+	}
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrCloneMismatch = errors.New("the built tournament does not match the source tournament")
+)
+
+// Creates a copy of the source tournament.
+//
+// The tournament structure (rankings, slots, graphs) can not be copied
+// directly because it is interlinked. Instead the given build function
+// is called to create a fresh tournament which has to use the same
+// entries and settings as the source. Then the state of every match
+// (score, times, location, withdrawals and terminations) is copied over and the
+// copy is updated.
+//
+// State that is not stored in the matches (e.g. tie breakers or
+// qualification overrides) has to be applied by the build function.
+func CloneTournament[T Tournament](source T, build func() (T, error)) (T, error) {
+	clone, err := build()
+	if err != nil {
+		return clone, err
+	}
+
+	sourceMatches := source.MatchList().Matches
+	cloneMatches := clone.MatchList().Matches
+	if len(sourceMatches) != len(cloneMatches) {
+		return clone, ErrCloneMismatch
+	}
+
+	for i, m := range sourceMatches {
+		copyMatchState(m, cloneMatches[i])
+	}
+
+	updateUntilStable(clone)
+
+	return clone, nil
+}
+
+// Copies the result and meta data of the source match to the target
+func copyMatchState(source, target *Match) {
+	target.Score = source.Score
+	target.Location = source.Location
+	target.StartTime = source.StartTime
+	target.EndTime = source.EndTime
+	target.TimeLimit = source.TimeLimit
+	target.WithdrawnPlayers = slices.Clone(source.WithdrawnPlayers)
+	target.DisqualifiedPlayers = slices.Clone(source.DisqualifiedPlayers)
+	target.Termination = source.Termination
+	target.TerminatedPlayer = source.TerminatedPlayer
+}
+
+// Updates the tournament until the occupants of all match slots
+// stop changing.
+//
+// A single update is not always enough when many results
+// changed at once because a ranking can be visited by the update
+// before all rankings that it depends on are updated.
+func updateUntilStable(tournament Tournament) {
+	matches := tournament.MatchList().Matches
+	occupants := matchOccupants(matches)
+
+	for range len(matches) + 1 {
+		tournament.Update(nil)
+		updatedOccupants := matchOccupants(matches)
+		if slices.Equal(occupants, updatedOccupants) {
+			return
+		}
+		occupants = updatedOccupants
+	}
+}
+
+// Returns the players in the slots of the matches
+// as a flat slice (two entries per match)
+func matchOccupants(matches []*Match) []Player {
+	occupants := make([]Player, 0, 2*len(matches))
+	for _, m := range matches {
+		occupants = append(occupants, m.Slot1.Player, m.Slot2.Player)
+	}
+	return occupants
+}
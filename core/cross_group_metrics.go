@@ -39,6 +39,7 @@ type crossGroupMatchMetricSource struct {
 
 func (s *crossGroupMatchMetricSource) CreateMetrics(
 	players []Player,
+	settings MetricSettings,
 ) map[Player]*MatchMetrics {
 	groupsFinished := true
 	for _, group := range s.groups {
@@ -50,7 +51,7 @@ func (s *crossGroupMatchMetricSource) CreateMetrics(
 		}
 	}
 	if !groupsFinished {
-		return s.baseMatchMetricSource.CreateMetrics(players)
+		return s.baseMatchMetricSource.CreateMetrics(players, settings)
 	}
 
 	numGroups := len(s.groups)
@@ -59,7 +60,7 @@ func (s *crossGroupMatchMetricSource) CreateMetrics(
 	maxGroupSize := len(s.groups[numGroups-1].Entries.Ranks())
 
 	if minGroupSize == maxGroupSize {
-		return s.baseMatchMetricSource.CreateMetrics(players)
+		return s.baseMatchMetricSource.CreateMetrics(players, settings)
 	}
 
 	largeGroups := make([]*RoundRobin, 0)
@@ -75,28 +76,19 @@ func (s *crossGroupMatchMetricSource) CreateMetrics(
 
 	metrics := make(map[Player]*MatchMetrics)
 
-	walkoverPoints := s.walkoverScore.Points1()
-	walkoverSetWins := len(walkoverPoints)
-	walkoverPointWins := 0
-	for _, setPoints := range walkoverPoints {
-		walkoverPointWins += setPoints
-	}
-
 	walkoverMetrics := &MatchMetrics{
 		NumMatches: 1,
 		Wins:       1,
-		NumSets:    walkoverSetWins,
-		SetWins:    walkoverSetWins,
-		PointWins:  walkoverPointWins,
 	}
+	addScoreMetrics(s.walkoverScore, settings, walkoverMetrics, &MatchMetrics{})
 
 	for _, group := range largeGroups {
 		lastPlaced := getLastOfGroup(group)
 		matchesWithLast, matchesWithoutLast := separateMatchesByPlayer(group.Matches, lastPlaced)
 		metricsWithLast := make(map[Player]*MatchMetrics)
 		metricsWithoutLast := make(map[Player]*MatchMetrics)
-		s.extractMatchMetricsFromSlice(matchesWithLast, nil, metricsWithLast)
-		s.extractMatchMetricsFromSlice(matchesWithoutLast, nil, metricsWithoutLast)
+		s.extractMatchMetricsFromSlice(matchesWithLast, nil, settings, metricsWithLast)
+		s.extractMatchMetricsFromSlice(matchesWithoutLast, nil, settings, metricsWithoutLast)
 		for _, metrics := range metricsWithoutLast {
 			metrics.Add(walkoverMetrics)
 		}
@@ -109,7 +101,7 @@ func (s *crossGroupMatchMetricSource) CreateMetrics(
 
 	for _, group := range smallGroups {
 		groupMetrics := make(map[Player]*MatchMetrics)
-		s.extractMatchMetricsFromSlice(group.Matches, nil, groupMetrics)
+		s.extractMatchMetricsFromSlice(group.Matches, nil, settings, groupMetrics)
 		for _, metrics := range groupMetrics {
 			metrics.Add(walkoverMetrics)
 		}
@@ -4,6 +4,7 @@ package core
 
 import (
 	"iter"
+	"slices"
 	"sync"
 
 	"github.com/dominikbraun/graph"
@@ -70,6 +71,24 @@ func (g *DependencyGraph[T]) GetDependants(source T) []T {
 	return dependants
 }
 
+// Returns all nodes of the graph ordered by their ID
+func (g *DependencyGraph[T]) Nodes() []T {
+	adjacencyMap, _ := g.Graph.AdjacencyMap()
+	ids := make([]int, 0, len(adjacencyMap))
+	for id := range adjacencyMap {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	nodes := make([]T, 0, len(ids))
+	for _, id := range ids {
+		node, _ := g.Vertex(id)
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
 // A RankingGraph contains all rankings of a tournament as its
 // nodes. The directed edges between the nodes model the dependencies
 // between the rankings.
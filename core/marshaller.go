@@ -84,13 +84,21 @@ func (m *TournamentMarshaller) marshalTies(ties [][]*Slot) [][]map[string]any {
 }
 
 func (m *TournamentMarshaller) marshalMetrics(ranking *MatchMetricRanking) []*MatchMetrics {
+	return m.marshalRankedMetrics(ranking, ranking.Metrics)
+}
+
+// Returns the metrics of the players in the order of the ranking
+func (m *TournamentMarshaller) marshalRankedMetrics(
+	ranking Ranking,
+	playerMetrics map[Player]*MatchMetrics,
+) []*MatchMetrics {
 	slots := ranking.Ranks()
 	metrics := make([]*MatchMetrics, 0, len(slots))
 	for _, s := range slots {
 		if s.Player == nil {
 			continue
 		}
-		metrics = append(metrics, ranking.Metrics[s.Player])
+		metrics = append(metrics, playerMetrics[s.Player])
 	}
 	return metrics
 }
@@ -130,6 +138,15 @@ func (m *TournamentMarshaller) marshalMatch(match *Match) map[string]any {
 	if err == nil && winner.Player != nil {
 		result["winner"] = winner.Player.Id()
 	}
+	switch match.Termination {
+	case Retirement:
+		result["termination"] = "retirement"
+	case Disqualification:
+		result["termination"] = "disqualification"
+	}
+	if len(match.DisqualifiedPlayers) > 0 {
+		result["disqualified"] = m.marshalPlayerIds(match.DisqualifiedPlayers)
+	}
 	return result
 }
 
@@ -186,6 +203,71 @@ func (m *TournamentMarshaller) marshalRoundRobin(tournament *RoundRobin) map[str
 	return result
 }
 
+func (m *TournamentMarshaller) marshalAmericano(tournament *Americano) map[string]any {
+	ranks := m.marshalEntriesAndFinal(tournament.Entries, tournament.FinalRanking)
+	matchList := m.marshalMatchList(tournament.matchList)
+	editable := m.marshalEditableMatches(tournament)
+
+	sittingOut := make([][]string, len(tournament.SittingOut))
+	for i, players := range tournament.SittingOut {
+		ids := make([]string, len(players))
+		for i, p := range players {
+			ids[i] = p.Id()
+		}
+		sittingOut[i] = ids
+	}
+
+	result := map[string]any{
+		"type":       "Americano",
+		"metrics":    m.marshalRankedMetrics(tournament.FinalRanking, tournament.FinalRanking.Metrics),
+		"sittingOut": sittingOut,
+	}
+
+	maps.Copy(result, matchList)
+	maps.Copy(result, editable)
+	maps.Copy(result, ranks)
+
+	return result
+}
+
+func (m *TournamentMarshaller) marshalLadder(tournament *Ladder) map[string]any {
+	ranks := m.marshalEntriesAndFinal(tournament.Entries, tournament.FinalRanking)
+	matchList := m.marshalMatchList(tournament.matchList)
+	editable := m.marshalEditableMatches(tournament)
+
+	challenges := make([]map[string]any, 0, len(tournament.Challenges()))
+	for _, c := range tournament.Challenges() {
+		challenges = append(challenges, map[string]any{
+			"match":      m.idMap[c.Match.id],
+			"challenger": c.Challenger.Id(),
+			"defender":   c.Defender.Id(),
+			"expired":    c.Expired,
+		})
+	}
+
+	history := make([]map[string]any, 0, len(tournament.FinalRanking.History))
+	for _, change := range tournament.FinalRanking.History {
+		history = append(history, map[string]any{
+			"match":  m.idMap[change.Challenge.Match.id],
+			"player": change.Player.Id(),
+			"from":   change.From,
+			"to":     change.To,
+		})
+	}
+
+	result := map[string]any{
+		"type":       "Ladder",
+		"challenges": challenges,
+		"history":    history,
+	}
+
+	maps.Copy(result, matchList)
+	maps.Copy(result, editable)
+	maps.Copy(result, ranks)
+
+	return result
+}
+
 func (m *TournamentMarshaller) marshalSingleEliminationWithConsolation(tournament *SingleEliminationWithConsolation) map[string]any {
 	ranks := m.marshalEntriesAndFinal(tournament.Entries, tournament.FinalRanking)
 	mainBracket := m.marshalConsolationBracket(tournament.MainBracket)
@@ -320,6 +402,60 @@ func (m *TournamentMarshaller) marshalGroupKnockout(tournament *GroupKnockout) m
 	return result
 }
 
+func (m *TournamentMarshaller) marshalQualifyingMainDraw(tournament *QualifyingMainDraw) map[string]any {
+	ranks := m.marshalEntriesAndFinal(tournament.Entries, tournament.FinalRanking)
+	editable := m.marshalEditableMatches(tournament)
+
+	luckyLosers := make([]string, 0)
+	for _, p := range tournament.LuckyLosers() {
+		luckyLosers = append(luckyLosers, p.Id())
+	}
+
+	qualifying := m.marshalSingleElimination(tournament.Qualifying)
+	mainDraw := m.marshalSingleElimination(tournament.MainDraw)
+	delete(qualifying, "editable")
+	delete(mainDraw, "editable")
+
+	result := map[string]any{
+		"type":            "QualifyingMainDraw",
+		"qualifying":      qualifying,
+		"mainDraw":        mainDraw,
+		"mainDrawStarted": tournament.MainDraw.matchList.MatchesStarted(),
+		"luckyLosers":     luckyLosers,
+	}
+
+	maps.Copy(result, editable)
+	maps.Copy(result, ranks)
+
+	return result
+}
+
+func (m *TournamentMarshaller) marshalPlayerIds(players []Player) []string {
+	ids := make([]string, 0, len(players))
+	for _, p := range players {
+		ids = append(ids, p.Id())
+	}
+	return ids
+}
+
+func (m *TournamentMarshaller) marshalReserveList(list *ReserveList) map[string]any {
+	players := m.marshalPlayerIds(list.Players)
+
+	offers := make([]map[string]any, 0, len(list.offers))
+	for _, o := range list.offers {
+		offers = append(offers, map[string]any{
+			"withdrawn": o.Withdrawn.Id(),
+			"reserve":   o.Reserve.Id(),
+		})
+	}
+
+	return map[string]any{
+		"players":  players,
+		"autoFill": list.AutoFill,
+		"offers":   offers,
+	}
+}
+
 func (m *Match) ToMap() map[string]any {
 	marshaller := TournamentMarshaller{}
 	return marshaller.marshalMatch(m)
@@ -327,25 +463,64 @@ func (m *Match) ToMap() map[string]any {
 
 func (t *SingleElimination) ToMap(getMatchId func(int) string) map[string]any {
 	marshaller := newTournamentMarshaller(t, getMatchId)
-	return marshaller.marshalSingleElimination(t)
+	result := marshaller.marshalSingleElimination(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(DisqualifiedPlayers(t.Matches))
+	return result
 }
 
 func (t *SingleEliminationWithConsolation) ToMap(getMatchId func(int) string) map[string]any {
 	marshaller := newTournamentMarshaller(t, getMatchId)
-	return marshaller.marshalSingleEliminationWithConsolation(t)
+	result := marshaller.marshalSingleEliminationWithConsolation(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(DisqualifiedPlayers(t.Matches))
+	return result
 }
 
 func (t *RoundRobin) ToMap(getMatchId func(int) string) map[string]any {
 	marshaller := newTournamentMarshaller(t, getMatchId)
-	return marshaller.marshalRoundRobin(t)
+	result := marshaller.marshalRoundRobin(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(DisqualifiedPlayers(t.Matches))
+	return result
+}
+
+func (t *Americano) ToMap(getMatchId func(int) string) map[string]any {
+	marshaller := newTournamentMarshaller(t, getMatchId)
+	result := marshaller.marshalAmericano(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(DisqualifiedPlayers(t.Matches))
+	return result
+}
+
+func (t *Ladder) ToMap(getMatchId func(int) string) map[string]any {
+	marshaller := newTournamentMarshaller(t, getMatchId)
+	result := marshaller.marshalLadder(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(t.FinalRanking.disqualified)
+	return result
 }
 
 func (t *GroupKnockout) ToMap(getMatchId func(int) string) map[string]any {
 	marshaller := newTournamentMarshaller(t, getMatchId)
-	return marshaller.marshalGroupKnockout(t)
+	result := marshaller.marshalGroupKnockout(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(DisqualifiedPlayers(t.Matches))
+	return result
 }
 
 func (t *DoubleElimination) ToMap(getMatchId func(int) string) map[string]any {
 	marshaller := newTournamentMarshaller(t, getMatchId)
-	return marshaller.marshalDoubleElimination(t)
+	result := marshaller.marshalDoubleElimination(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(DisqualifiedPlayers(t.Matches))
+	return result
+}
+
+func (t *QualifyingMainDraw) ToMap(getMatchId func(int) string) map[string]any {
+	marshaller := newTournamentMarshaller(t, getMatchId)
+	result := marshaller.marshalQualifyingMainDraw(t)
+	result["reserves"] = marshaller.marshalReserveList(&t.Reserves)
+	result["disqualified"] = marshaller.marshalPlayerIds(DisqualifiedPlayers(t.Matches))
+	return result
 }
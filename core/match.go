@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"iter"
+	"slices"
 	"strings"
 	"time"
 )
@@ -14,6 +15,24 @@ var (
 	ErrByeAndWalkover = errors.New("bye and walkover")
 	ErrNoScore        = errors.New("no score")
 	ErrEqualScore     = errors.New("equal score")
+	ErrDraw           = errors.New("the match is a draw")
+
+	ErrDrawNotAllowed = errors.New("the match can not end in a draw")
+
+	ErrPlayerNotInMatch = errors.New("the player is not in the match")
+)
+
+// A Termination describes how a match ended
+// before it was completed regularly
+type Termination int
+
+const (
+	// The match was not terminated
+	NotTerminated Termination = iota
+	// A player retired during the match (e.g. due to an injury)
+	Retirement Termination = iota
+	// A player was disqualified during the match
+	Disqualification Termination = iota
 )
 
 // A match with two slots for the opponents.
@@ -50,6 +69,28 @@ type Match struct {
 	// match
 	WithdrawnPlayers []Player
 
+	// A list of players who were disqualified from the
+	// tournament. They are excluded from the final rankings
+	// (see [WithdrawalPolicy.DisqualifyPlayer]).
+	DisqualifiedPlayers []Player
+
+	// Set when the match ended early by a retirement or
+	// disqualification (see [Match.Retire] and [Match.Disqualify]).
+	// The Score then holds the points that were played
+	// until the termination.
+	Termination Termination
+
+	// The player who retired or was disqualified
+	TerminatedPlayer Player
+
+	// The duration of a timed match. The opponent who leads when
+	// the time is up wins. Is 0 when the match is not timed.
+	TimeLimit time.Duration
+
+	// When true the match can end in a draw (see [DrawableScore]).
+	// Otherwise a drawn score has no winner like an equal score.
+	AllowDraw bool
+
 	// Id for graph node hashing
 	id int
 }
@@ -82,10 +123,18 @@ func (m *Match) GetWinner() (*Slot, error) {
 		return m.Slot2, nil
 	}
 
+	if terminated := m.TerminatedSlot(); terminated != nil {
+		return m.OtherSlot(terminated), nil
+	}
+
 	if m.Score == nil {
 		return nil, ErrNoScore
 	}
 
+	if m.IsDraw() {
+		return nil, ErrDraw
+	}
+
 	winnerIndex, err := m.Score.GetWinner()
 	if err != nil {
 		return nil, ErrEqualScore
@@ -121,10 +170,10 @@ func (m *Match) WithdrawnSlots() []*Slot {
 
 	withdrawn1, withdrawn2 := false, false
 	for _, p := range m.WithdrawnPlayers {
-		if m.Slot1.Player != nil && p.Id() == m.Slot1.Player.Id() {
+		if m.Slot1.Player != nil && matchesPlayer(m.Slot1.Player, p) {
 			withdrawn1 = true
 		}
-		if m.Slot2.Player != nil && p.Id() == m.Slot2.Player.Id() {
+		if m.Slot2.Player != nil && matchesPlayer(m.Slot2.Player, p) {
 			withdrawn2 = true
 		}
 	}
@@ -139,6 +188,33 @@ func (m *Match) WithdrawnSlots() []*Slot {
 	return withdrawn
 }
 
+// Returns true when the match is allowed to end in a draw
+// and its score is a draw. A terminated match is never a draw
+// since the opponent of the terminated player wins.
+func (m *Match) IsDraw() bool {
+	return m.AllowDraw && !m.IsTerminated() && isDrawScore(m.Score)
+}
+
+// Returns the slot that is occupied by the player who retired or was
+// disqualified. Returns nil when the match was not terminated.
+func (m *Match) TerminatedSlot() *Slot {
+	if m.Termination == NotTerminated || m.TerminatedPlayer == nil {
+		return nil
+	}
+	for slot := range m.Slots {
+		if slot.Player != nil && matchesPlayer(slot.Player, m.TerminatedPlayer) {
+			return slot
+		}
+	}
+	return nil
+}
+
+// Returns true when the match ended by a retirement
+// or disqualification
+func (m *Match) IsTerminated() bool {
+	return m.TerminatedSlot() != nil
+}
+
 func (m *Match) IsWalkover() bool {
 	return len(m.WithdrawnSlots()) > 0
 }
@@ -154,22 +230,48 @@ func (m *Match) HasDrawnBye() bool {
 	return (bye1 != nil && bye1.Drawn) || (bye2 != nil && bye2.Drawn)
 }
 
+// Returns true when the given player occupies one of the slots
+// or is a member of a composite player in one of the slots
+// (see [CompositePlayer])
 func (m *Match) ContainsPlayer(player Player) bool {
-	id := player.Id()
 	for slot := range m.Slots {
-		if slot.Player != nil && slot.Player.Id() == id {
+		if slot.Player != nil && matchesPlayer(slot.Player, player) {
 			return true
 		}
 	}
 	return false
 }
 
+// Returns true when the given player was disqualified
+// from the tournament in this match
+func (m *Match) IsPlayerDisqualified(player Player) bool {
+	return slices.ContainsFunc(
+		m.DisqualifiedPlayers,
+		func(p Player) bool { return matchesPlayer(p, player) },
+	)
+}
+
+// Returns the players who were disqualified from the
+// tournament in the given matches
+func DisqualifiedPlayers(matches []*Match) []Player {
+	disqualified := make([]Player, 0)
+	for _, m := range matches {
+		for _, p := range m.DisqualifiedPlayers {
+			if !slices.Contains(disqualified, p) {
+				disqualified = append(disqualified, p)
+			}
+		}
+	}
+	return disqualified
+}
+
 // Returns true when the given player has withdrawn
-// and is occupying one of the slots
+// and is occupying one of the slots (directly or as member
+// of a composite player)
 func (m *Match) IsPlayerWithdrawn(player Player) bool {
 	withdrawnSlots := m.WithdrawnSlots()
 	for _, s := range withdrawnSlots {
-		if s.Player.Id() == player.Id() {
+		if matchesPlayer(s.Player, player) {
 			return true
 		}
 	}
@@ -185,6 +287,59 @@ func (m *Match) StartMatch() error {
 }
 
 func (m *Match) EndMatch(score Score) error {
+	if m.StartTime.IsZero() {
+		return errors.New("Match cannot end before it started")
+	}
+	if !m.EndTime.IsZero() {
+		return errors.New("Match already ended")
+	}
+	if !m.AllowDraw && isDrawScore(score) {
+		return ErrDrawNotAllowed
+	}
+	m.Score = score
+	m.EndTime = time.Now()
+	return nil
+}
+
+// Returns the time when a timed match is up or
+// zero when the match is not timed or not started
+func (m *Match) Deadline() time.Time {
+	if m.TimeLimit == 0 || m.StartTime.IsZero() {
+		return time.Time{}
+	}
+	return m.StartTime.Add(m.TimeLimit)
+}
+
+// Returns true when the time of a timed match is up
+// and the match has not ended yet
+func (m *Match) IsTimeUp(now time.Time) bool {
+	deadline := m.Deadline()
+	if deadline.IsZero() || !m.EndTime.IsZero() {
+		return false
+	}
+	return !now.Before(deadline)
+}
+
+// Ends the match with the retirement of the given player.
+// The score is the partial score at the time of the retirement
+// and may be nil when no points were played.
+// The opponent of the player wins the match.
+func (m *Match) Retire(player Player, score Score) error {
+	return m.terminate(Retirement, player, score)
+}
+
+// Ends the match with the disqualification of the given player.
+// The score is the partial score at the time of the disqualification
+// and may be nil when no points were played.
+// The opponent of the player wins the match.
+func (m *Match) Disqualify(player Player, score Score) error {
+	return m.terminate(Disqualification, player, score)
+}
+
+func (m *Match) terminate(termination Termination, player Player, score Score) error {
+	if !m.ContainsPlayer(player) {
+		return ErrPlayerNotInMatch
+	}
 	if m.StartTime.IsZero() {
 		return errors.New("Match cannot end before it started")
 	}
@@ -192,6 +347,8 @@ func (m *Match) EndMatch(score Score) error {
 		return errors.New("Match already ended")
 	}
 	m.Score = score
+	m.Termination = termination
+	m.TerminatedPlayer = entryOfPlayer(m, player)
 	m.EndTime = time.Now()
 	return nil
 }
@@ -279,6 +436,51 @@ type Score interface {
 	Invert() Score
 }
 
+// A DrawableScore is a Score that can declare
+// a draw instead of a winner.
+type DrawableScore interface {
+	Score
+
+	// Returns true when the score is a draw.
+	// GetWinner errors on drawn scores.
+	IsDraw() bool
+}
+
+// A CompositeScore is a Score that is made up of the scores
+// of multiple sub-matches (e.g. the rubbers of a team tie).
+// The winner of a composite score is the opponent who won
+// more of its components.
+//
+// The match metrics count the won components as rubbers and
+// the sets and points of the components instead of Points1
+// and Points2 of the composite score itself.
+type CompositeScore interface {
+	Score
+
+	// Returns the scores of the components that were played
+	// from the perspective of the first opponent.
+	Components() []Score
+}
+
+// A HandicapScore is a Score where the opponents started
+// each set with a head start. Points1 and Points2 include
+// the head start.
+//
+// The match metrics count the points net of the head start
+// when the NetHandicap of the MetricSettings is set.
+type HandicapScore interface {
+	Score
+
+	// Returns the head start points of the first
+	// and second opponent in each set
+	Handicap() (int, int)
+}
+
+func isDrawScore(score Score) bool {
+	drawable, ok := score.(DrawableScore)
+	return ok && drawable.IsDraw()
+}
+
 // A Round is a list of matches that can be played in
 // parallel during a tournament.
 // The matches of a round depend on the completion
@@ -292,6 +494,58 @@ type Round struct {
 	NestedRounds []*Round
 }
 
+// Sets the time limit of all matches in the round (see [Match.TimeLimit])
+func (r *Round) SetTimeLimit(limit time.Duration) {
+	for _, m := range r.Matches {
+		m.TimeLimit = limit
+	}
+}
+
+// Starts all matches of the round that are ready to be played at the
+// same time. Matches with byes, walkovers, empty slots or that already
+// started are skipped. The started matches are returned.
+//
+// This is meant for timed rounds where all matches start and end
+// together (see [Round.Deadline]).
+func (r *Round) StartMatches(now time.Time) []*Match {
+	started := make([]*Match, 0, len(r.Matches))
+	for _, m := range r.Matches {
+		if m.Slot1.Player == nil || m.Slot2.Player == nil {
+			continue
+		}
+		if m.HasBye() || m.IsWalkover() || !m.StartTime.IsZero() {
+			continue
+		}
+		m.StartTime = now
+		started = append(started, m)
+	}
+	return started
+}
+
+// Returns the latest deadline of the timed matches in the round.
+// Is zero when no timed match of the round started.
+func (r *Round) Deadline() time.Time {
+	var deadline time.Time
+	for _, m := range r.Matches {
+		if d := m.Deadline(); d.After(deadline) {
+			deadline = d
+		}
+	}
+	return deadline
+}
+
+// Returns the matches of the round whose time is up
+// but that did not end yet
+func (r *Round) TimedOutMatches(now time.Time) []*Match {
+	timedOut := make([]*Match, 0, len(r.Matches))
+	for _, m := range r.Matches {
+		if m.IsTimeUp(now) {
+			timedOut = append(timedOut, m)
+		}
+	}
+	return timedOut
+}
+
 // A Location is a court or a field
 // where a match is played on
 type Location interface {
@@ -1,6 +1,10 @@
 package core
 
-import "errors"
+import (
+	"errors"
+	"testing"
+	"time"
+)
 
 type TestScore struct {
 	a, b int
@@ -39,6 +43,11 @@ func (s *TestScore) GetWinner() (int, error) {
 	return -1, errors.New("No winner")
 }
 
+// Returns true when both opponents have equal points
+func (s *TestScore) IsDraw() bool {
+	return s.a == s.b
+}
+
 func (s *TestScore) Invert() Score {
 	return NewScore(s.b, s.a)
 }
@@ -46,3 +55,114 @@ func (s *TestScore) Invert() Score {
 func NewScore(a, b int) *TestScore {
 	return &TestScore{a, b, 1}
 }
+
+func TestMatchDraw(t *testing.T) {
+	players, err := PlayerSlice(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match := NewMatch(NewPlayerSlot(players[0]), NewPlayerSlot(players[1]))
+	match.StartMatch()
+
+	err = match.EndMatch(NewScore(1, 1))
+	if err != ErrDrawNotAllowed || match.Score != nil {
+		t.Fatal("A draw was accepted by a match that does not allow draws")
+	}
+
+	match.AllowDraw = true
+	err = match.EndMatch(NewScore(1, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	winner, err := match.GetWinner()
+	if winner != nil || err != ErrDraw || !match.IsDraw() {
+		t.Fatal("The drawn match did not report a draw")
+	}
+
+	match.AllowDraw = false
+	_, err = match.GetWinner()
+	if err != ErrEqualScore || match.IsDraw() {
+		t.Fatal("The drawn score was not treated as an equal score")
+	}
+}
+
+func TestMatchTermination(t *testing.T) {
+	players, err := PlayerSlice(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match := NewMatch(NewPlayerSlot(players[0]), NewPlayerSlot(players[1]))
+
+	err = match.Retire(players[0], NewScore(15, 11))
+	if err == nil || match.IsTerminated() {
+		t.Fatal("An unstarted match was terminated")
+	}
+
+	match.StartMatch()
+	err = match.Retire(players[2], NewScore(15, 11))
+	if err != ErrPlayerNotInMatch {
+		t.Fatal("A player who is not in the match retired")
+	}
+
+	err = match.Retire(players[0], NewScore(15, 11))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	winner, _ := match.GetWinner()
+	eq1 := winner == match.Slot2 && match.TerminatedSlot() == match.Slot1
+	eq2 := match.Termination == Retirement && match.Score.Points1()[0] == 15
+	if !eq1 || !eq2 || match.IsWalkover() {
+		t.Fatal("The opponent of the retired player did not win")
+	}
+
+	err = match.Disqualify(players[1], nil)
+	if err == nil {
+		t.Fatal("A terminated match was terminated again")
+	}
+
+	disqualified := NewMatch(NewPlayerSlot(players[0]), NewPlayerSlot(players[1]))
+	disqualified.StartMatch()
+	disqualified.Disqualify(players[1], nil)
+	winner, _ = disqualified.GetWinner()
+	if winner != disqualified.Slot1 || disqualified.Termination != Disqualification {
+		t.Fatal("The opponent of the disqualified player did not win")
+	}
+}
+
+func TestTimedRound(t *testing.T) {
+	players, err := PlayerSlice(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players[:5])
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	round := tournament.Rounds[0]
+
+	round.SetTimeLimit(12 * time.Minute)
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	started := round.StartMatches(start)
+
+	if len(started) != 2 || !round.Deadline().Equal(start.Add(12*time.Minute)) {
+		t.Fatal("the playable matches of the round did not start together")
+	}
+
+	if len(round.TimedOutMatches(start.Add(11*time.Minute))) != 0 {
+		t.Fatal("the matches timed out before the time limit")
+	}
+
+	end := start.Add(12 * time.Minute)
+	started[0].EndMatch(NewScore(9, 5))
+	timedOut := round.TimedOutMatches(end)
+	if len(timedOut) != 1 || timedOut[0] != started[1] {
+		t.Fatal("the running match did not time out")
+	}
+
+	if len(round.StartMatches(end)) != 0 {
+		t.Fatal("started matches were started again")
+	}
+}
@@ -5,8 +5,24 @@ import "slices"
 type MatchMetrics struct {
 	NumMatches int `json:"numMatches"`
 	Wins       int `json:"wins"`
+	Draws      int `json:"draws"`
 	Losses     int `json:"losses"`
 
+	// The subset of the wins and losses that
+	// were walkovers
+	WalkoverWins   int `json:"walkoverWins"`
+	WalkoverLosses int `json:"walkoverLosses"`
+
+	// The points total according to the PointsTable of
+	// the ranking. Is 0 when the ranking has no PointsTable.
+	Points int `json:"points"`
+
+	// The components of composite scores (see [CompositeScore]).
+	// Are 0 for regular scores.
+	NumRubbers   int `json:"numRubbers"`
+	RubberWins   int `json:"rubberWins"`
+	RubberLosses int `json:"rubberLosses"`
+
 	NumSets   int `json:"numSets"`
 	SetWins   int `json:"setWins"`
 	SetLosses int `json:"setLosses"`
@@ -14,13 +30,15 @@ type MatchMetrics struct {
 	PointWins   int `json:"pointWins"`
 	PointLosses int `json:"pointLosses"`
 
-	SetDifference   int `json:"-"`
-	PointDifference int `json:"-"`
+	RubberDifference int `json:"-"`
+	SetDifference    int `json:"-"`
+	PointDifference  int `json:"-"`
 
 	Withdrawn bool `json:"-"`
 }
 
 func (m *MatchMetrics) UpdateDifferences() {
+	m.RubberDifference = m.RubberWins - m.RubberLosses
 	m.SetDifference = m.SetWins - m.SetLosses
 	m.PointDifference = m.PointWins - m.PointLosses
 }
@@ -29,7 +47,14 @@ func (m *MatchMetrics) UpdateDifferences() {
 func (m *MatchMetrics) Add(other *MatchMetrics) {
 	m.NumMatches += other.NumMatches
 	m.Wins += other.Wins
+	m.Draws += other.Draws
 	m.Losses += other.Losses
+	m.WalkoverWins += other.WalkoverWins
+	m.WalkoverLosses += other.WalkoverLosses
+
+	m.NumRubbers += other.NumRubbers
+	m.RubberWins += other.RubberWins
+	m.RubberLosses += other.RubberLosses
 
 	m.NumSets += other.NumSets
 	m.SetWins += other.SetWins
@@ -41,6 +66,52 @@ func (m *MatchMetrics) Add(other *MatchMetrics) {
 	m.UpdateDifferences()
 }
 
+// A TerminationRule decides how the match metrics count
+// a match that ended by a retirement or disqualification
+// (see [Match.Termination])
+type TerminationRule int
+
+const (
+	// Counts the points that were played until the termination.
+	// The match counts as a regular win and loss.
+	CountPlayedScore TerminationRule = iota
+	// Counts the match like a walkover. The played points are
+	// replaced by the walkover score.
+	CountWalkoverScore TerminationRule = iota
+)
+
+// A WithdrawalRule decides whether the matches of a player
+// who withdrew from a round robin keep counting
+type WithdrawalRule int
+
+const (
+	// The matches that the withdrawn player played keep counting
+	KeepPlayedResults WithdrawalRule = iota
+	// All matches of the withdrawn player are annulled
+	AnnulAllResults
+	// The matches of the withdrawn player are annulled when they
+	// played less than the AnnulBelowPercent of their matches
+	AnnulBelowPercent
+)
+
+// The MetricSettings configure how the match
+// metrics are extracted from the matches
+type MetricSettings struct {
+	Termination TerminationRule
+
+	// The rule for the matches of withdrawn players.
+	// Annulled matches do not count for either opponent.
+	Withdrawal WithdrawalRule
+	// The percentage of matches that a withdrawn player has
+	// to have played to keep their results with AnnulBelowPercent
+	// (e.g. 100 annuls the results unless all matches were played)
+	AnnulPercent int
+
+	// When true the points of handicap scores are counted
+	// without the head start (see [HandicapScore])
+	NetHandicap bool
+}
+
 type baseMatchMetricSource struct {
 	matches       []*Match
 	walkoverScore Score
@@ -51,19 +122,35 @@ type baseMatchMetricSource struct {
 // opponents are in the slice are counted.
 func (s *baseMatchMetricSource) CreateMetrics(
 	players []Player,
+	settings MetricSettings,
 ) map[Player]*MatchMetrics {
 	metrics := make(map[Player]*MatchMetrics)
-	s.extractMatchMetricsFromSlice(s.matches, players, metrics)
+	s.extractMatchMetricsFromSlice(s.matches, players, settings, metrics)
 	return metrics
 }
 
+func (s *baseMatchMetricSource) disqualifiedPlayers() []Player {
+	return DisqualifiedPlayers(s.matches)
+}
+
 func (s *baseMatchMetricSource) extractMatchMetricsFromSlice(
 	matches []*Match,
 	players []Player,
+	settings MetricSettings,
 	metrics map[Player]*MatchMetrics,
 ) {
+	annulled := annulledPlayers(matches, settings)
+	for _, p := range annulled {
+		if len(players) == 0 || slices.Contains(players, p) {
+			metrics[p] = &MatchMetrics{Withdrawn: true}
+		}
+	}
+
 	for _, match := range matches {
-		s.extractMatchMetrics(match, players, metrics)
+		isAnnulled := slices.ContainsFunc(annulled, match.ContainsPlayer)
+		if !isAnnulled {
+			s.extractMatchMetrics(match, players, settings, metrics)
+		}
 	}
 
 	for _, m := range metrics {
@@ -71,9 +158,51 @@ func (s *baseMatchMetricSource) extractMatchMetricsFromSlice(
 	}
 }
 
+// Returns the withdrawn players whose matches are
+// annulled according to the WithdrawalRule
+func annulledPlayers(matches []*Match, settings MetricSettings) []Player {
+	if settings.Withdrawal == KeepPlayedResults {
+		return nil
+	}
+
+	withdrawn := make([]Player, 0, 2)
+	for _, m := range matches {
+		for _, slot := range m.WithdrawnSlots() {
+			if !slices.Contains(withdrawn, slot.Player) {
+				withdrawn = append(withdrawn, slot.Player)
+			}
+		}
+	}
+
+	if settings.Withdrawal == AnnulAllResults {
+		return withdrawn
+	}
+
+	annulled := make([]Player, 0, len(withdrawn))
+	for _, p := range withdrawn {
+		numMatches := 0
+		numPlayed := 0
+		for _, m := range matches {
+			if m.HasBye() || !m.ContainsPlayer(p) {
+				continue
+			}
+			numMatches += 1
+			if m.Score != nil || m.IsTerminated() {
+				numPlayed += 1
+			}
+		}
+		if 100*numPlayed < settings.AnnulPercent*numMatches {
+			annulled = append(annulled, p)
+		}
+	}
+
+	return annulled
+}
+
 func (s *baseMatchMetricSource) extractMatchMetrics(
 	match *Match,
 	players []Player,
+	settings MetricSettings,
 	metrics map[Player]*MatchMetrics,
 ) {
 	p1 := match.Slot1.Player
@@ -89,10 +218,10 @@ func (s *baseMatchMetricSource) extractMatchMetrics(
 	}
 
 	winnerSlot, _ := match.GetWinner()
-	if winnerSlot == nil {
+	draw := match.IsDraw()
+	if winnerSlot == nil && !draw {
 		return
 	}
-	winner := winnerSlot.Player
 
 	m1, ok := metrics[p1]
 	if !ok {
@@ -109,24 +238,80 @@ func (s *baseMatchMetricSource) extractMatchMetrics(
 	m1.NumMatches += 1
 	m2.NumMatches += 1
 
-	if winner == p1 {
+	switch {
+	case draw:
+		m1.Draws += 1
+		m2.Draws += 1
+	case winnerSlot.Player == p1:
 		m1.Wins += 1
 		m2.Losses += 1
-	} else {
+	default:
 		m2.Wins += 1
 		m1.Losses += 1
 	}
 
 	score := match.Score
-	if score == nil {
+	terminated := match.IsTerminated()
+	countAsWalkover := score == nil && !terminated
+	if terminated && settings.Termination == CountWalkoverScore {
+		countAsWalkover = true
+	}
+
+	if countAsWalkover {
 		switch winnerSlot {
 		case match.Slot1:
 			score = s.walkoverScore
-			m2.Withdrawn = true
+			m1.WalkoverWins += 1
+			m2.WalkoverLosses += 1
+			m2.Withdrawn = m2.Withdrawn || !terminated
 		case match.Slot2:
 			score = s.walkoverScore.Invert()
-			m1.Withdrawn = true
+			m2.WalkoverWins += 1
+			m1.WalkoverLosses += 1
+			m1.Withdrawn = m1.Withdrawn || !terminated
+		}
+	}
+
+	if score == nil {
+		// The match was terminated before any points were played
+		return
+	}
+
+	addScoreMetrics(score, settings, m1, m2)
+}
+
+// Adds the sets and points of the score to the metrics of
+// the opponents. Composite scores also add their rubbers.
+func addScoreMetrics(score Score, settings MetricSettings, m1, m2 *MatchMetrics) {
+	composite, ok := score.(CompositeScore)
+	if !ok {
+		addSetMetrics(score, settings, m1, m2)
+		return
+	}
+
+	for _, component := range composite.Components() {
+		m1.NumRubbers += 1
+		m2.NumRubbers += 1
+
+		winner, err := component.GetWinner()
+		switch {
+		case err != nil:
+		case winner == 0:
+			m1.RubberWins += 1
+			m2.RubberLosses += 1
+		default:
+			m2.RubberWins += 1
+			m1.RubberLosses += 1
 		}
+
+		addSetMetrics(component, settings, m1, m2)
+	}
+}
+
+func addSetMetrics(score Score, settings MetricSettings, m1, m2 *MatchMetrics) {
+	handicap1, handicap2 := 0, 0
+	if handicapScore, ok := score.(HandicapScore); ok && settings.NetHandicap {
+		handicap1, handicap2 = handicapScore.Handicap()
 	}
 
 	score1 := score.Points1()
@@ -138,11 +323,15 @@ func (s *baseMatchMetricSource) extractMatchMetrics(
 		points1 := score1[i]
 		points2 := score2[i]
 
-		m1.PointWins += points1
-		m1.PointLosses += points2
-		m2.PointWins += points2
-		m2.PointLosses += points1
+		net1 := points1 - handicap1
+		net2 := points2 - handicap2
+
+		m1.PointWins += net1
+		m1.PointLosses += net2
+		m2.PointWins += net2
+		m2.PointLosses += net1
 
+		// The set winner is decided by the points including the head start
 		if points1 == points2 {
 			continue
 		}
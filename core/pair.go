@@ -0,0 +1,141 @@
+package core
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrSamePartner      = errors.New("a pair can not consist of the same player twice")
+	ErrNotAMember       = errors.New("the player is not a member of the pair")
+	ErrAlreadyMember    = errors.New("the player is already a member of the pair")
+	ErrAlreadyEntered   = errors.New("the player is already entered in the tournament")
+	ErrMatchInProgress  = errors.New("the pair has a match in progress")
+	ErrPairNotInMatches = errors.New("the pair has no matches in the tournament")
+)
+
+// A CompositePlayer is a Player that consists of multiple
+// individual players (e.g. a doubles pair).
+//
+// Matches can be looked up by the individual members
+// (see [Match.ContainsPlayer]) and withdrawing a member
+// withdraws the whole composite player.
+type CompositePlayer interface {
+	Player
+
+	// Returns the individual players
+	Members() []Player
+}
+
+// A Substitution records the replacement of
+// one member of a pair
+type Substitution struct {
+	Out, In Player
+
+	Time time.Time
+}
+
+// A Pair is a doubles pair of two individual players.
+//
+// The ID of a pair is independent of its members so that
+// a partner can be substituted without changing the identity
+// of the pair in the rankings.
+type Pair struct {
+	id      string
+	members []Player
+
+	// The partner substitutions in chronological order
+	Substitutions []Substitution
+}
+
+func (p *Pair) Id() string {
+	return p.id
+}
+
+func (p *Pair) Members() []Player {
+	return slices.Clone(p.members)
+}
+
+// Replaces the member out with the player in
+// and records the substitution
+func (p *Pair) Substitute(out, in Player) error {
+	i := slices.IndexFunc(p.members, func(m Player) bool { return m.Id() == out.Id() })
+	if i == -1 {
+		return ErrNotAMember
+	}
+	if matchesPlayer(p, in) {
+		return ErrAlreadyMember
+	}
+
+	p.members[i] = in
+	p.Substitutions = append(p.Substitutions, Substitution{
+		Out:  out,
+		In:   in,
+		Time: time.Now(),
+	})
+
+	return nil
+}
+
+// Creates a pair with the given ID of the two players
+func NewPair(id string, player1, player2 Player) (*Pair, error) {
+	if player1.Id() == player2.Id() {
+		return nil, ErrSamePartner
+	}
+
+	pair := &Pair{
+		id:      id,
+		members: []Player{player1, player2},
+	}
+
+	return pair, nil
+}
+
+// Substitutes the partner out of the pair with the player in.
+//
+// The substitution is only possible while the pair has no match
+// in progress and when the new partner is not entered in any
+// other match of the tournament. The rankings are not affected
+// because the pair keeps its identity.
+func (l *matchList) SubstitutePartner(pair *Pair, out, in Player) error {
+	pairMatches := l.MatchesOfPlayer(pair)
+	if len(pairMatches) == 0 {
+		return ErrPairNotInMatches
+	}
+
+	for _, m := range pairMatches {
+		inProgress := !m.StartTime.IsZero() && m.EndTime.IsZero()
+		if inProgress {
+			return ErrMatchInProgress
+		}
+	}
+
+	for _, m := range l.Matches {
+		for slot := range m.Slots {
+			if slot.Player != nil && slot.Player != pair && matchesPlayer(slot.Player, in) {
+				return ErrAlreadyEntered
+			}
+		}
+	}
+
+	return pair.Substitute(out, in)
+}
+
+// Returns true when the entry is the player or when
+// the entry is a composite player with the player as member
+func matchesPlayer(entry, player Player) bool {
+	if entry.Id() == player.Id() {
+		return true
+	}
+
+	composite, ok := entry.(CompositePlayer)
+	if !ok {
+		return false
+	}
+
+	return slices.ContainsFunc(
+		composite.Members(),
+		func(m Player) bool { return m.Id() == player.Id() },
+	)
+}
@@ -0,0 +1,120 @@
+package core
+
+import "testing"
+
+// Creates pairs of the given individual players
+func pairSlice(players []Player) []Player {
+	pairs := make([]Player, 0, len(players)/2)
+	for i := 0; i+1 < len(players); i += 2 {
+		pair, _ := NewPair(players[i].Id()+players[i+1].Id(), players[i], players[i+1])
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+func TestPair(t *testing.T) {
+	players, err := PlayerSlice(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewPair("p", players[0], players[0])
+	if err != ErrSamePartner {
+		t.Fatal("The pair of the same player did not error")
+	}
+
+	pair, _ := NewPair("p", players[0], players[1])
+
+	err = pair.Substitute(players[2], players[0])
+	if err != ErrNotAMember {
+		t.Fatal("Substituting a non-member did not error")
+	}
+	err = pair.Substitute(players[0], players[1])
+	if err != ErrAlreadyMember {
+		t.Fatal("Substituting with the partner did not error")
+	}
+
+	err = pair.Substitute(players[0], players[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members := pair.Members()
+	eq1 := members[0] == players[2] && members[1] == players[1]
+	eq2 := len(pair.Substitutions) == 1 && pair.Substitutions[0].Out == players[0]
+	if !eq1 || !eq2 || pair.Id() != "p" {
+		t.Fatal("The substitution did not replace the member")
+	}
+}
+
+func TestPairMatches(t *testing.T) {
+	players, err := PlayerSlice(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pairs := pairSlice(players[:8])
+	substitute := players[8]
+
+	entries := NewConstantRanking(pairs)
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+
+	memberMatches := tournament.MatchesOfPlayer(players[0])
+	if len(memberMatches) != 3 {
+		t.Fatal("The matches of a pair member were not found")
+	}
+
+	playMatch(tournament.Matches, pairs[0], pairs[1])
+
+	withdrawn := tournament.WithdrawPlayer(players[1])
+	tournament.Update(nil)
+
+	eq1 := len(withdrawn) == 3 && withdrawn[0].IsPlayerWithdrawn(pairs[0])
+	eq2 := withdrawn[0].IsPlayerWithdrawn(players[0]) && !withdrawn[0].IsPlayerWithdrawn(pairs[1])
+	if !eq1 || !eq2 {
+		t.Fatal("Withdrawing a member did not withdraw the pair")
+	}
+
+	reentered := tournament.ReenterPlayer(pairs[0])
+	tournament.Update(nil)
+	if len(reentered) != 3 || withdrawn[0].IsWalkover() {
+		t.Fatal("The pair was not reentered")
+	}
+
+	match := findMatch(tournament.Matches, pairs[0], pairs[2])
+	match.StartMatch()
+
+	pair := pairs[0].(*Pair)
+	err = tournament.SubstitutePartner(pair, players[1], substitute)
+	if err != ErrMatchInProgress {
+		t.Fatal("The substitution during a match did not error")
+	}
+
+	if match.Slot1.Player == pairs[0] {
+		match.EndMatch(NewScore(21, 0))
+	} else {
+		match.EndMatch(NewScore(0, 21))
+	}
+
+	err = tournament.SubstitutePartner(pair, players[1], players[2])
+	if err != ErrAlreadyEntered {
+		t.Fatal("The substitution with an entered player did not error")
+	}
+
+	err = tournament.SubstitutePartner(pair, players[1], substitute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tournament.Update(nil)
+
+	eq1 = len(tournament.MatchesOfPlayer(players[1])) == 0
+	eq2 = len(tournament.MatchesOfPlayer(substitute)) == 3
+	if !eq1 || !eq2 {
+		t.Fatal("The matches did not move to the substitute")
+	}
+
+	metrics := tournament.FinalRanking.Metrics[pairs[0]]
+	if metrics.Wins != 2 || tournament.FinalRanking.Ranks()[0].Player != pairs[0] {
+		t.Fatal("The substitution changed the ranking of the pair")
+	}
+}
@@ -0,0 +1,214 @@
+package core
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrMatchNotInTournament = errors.New("the match is not part of the tournament")
+)
+
+// The ResultPreview describes the consequences that a tentative
+// result would have on a tournament (see [PreviewResult]).
+// All matches and rankings refer to the previewed tournament
+// and not to the clone that the result was applied to.
+type ResultPreview struct {
+	// The match slots whose occupants change
+	SlotChanges []*SlotChange
+
+	// The rankings whose order changes
+	RankingChanges []*RankingChange
+
+	// The ties that would block the tournament from
+	// progressing and do not exist without the result
+	// (e.g. cross group ties of a [GroupPhaseRanking])
+	NewBlockingTies []*BlockingTie
+}
+
+// A SlotChange is a match slot that gets a new occupant.
+// When After is not nil the player advances into the match.
+type SlotChange struct {
+	Match *Match
+	// 1 or 2 for Slot1 or Slot2 of the match
+	SlotNumber int
+
+	Before, After Player
+}
+
+// A RankingChange holds the ranked players of a ranking
+// before and after the tentative result.
+// Each rank holds the players who are tied on it.
+type RankingChange struct {
+	Ranking Ranking
+
+	Before, After [][]Player
+}
+
+// A BlockingTie holds the tied players in a ranking
+// that require a tie breaker
+type BlockingTie struct {
+	Ranking Ranking
+
+	Players []Player
+}
+
+// Returns the players who would advance into a match
+func (p *ResultPreview) Advancing() []Player {
+	advancing := make([]Player, 0, len(p.SlotChanges))
+	for _, c := range p.SlotChanges {
+		if c.After != nil && !slices.Contains(advancing, c.After) {
+			advancing = append(advancing, c.After)
+		}
+	}
+	return advancing
+}
+
+// Previews the consequences of the score as the result of the
+// match without changing the tournament.
+//
+// The tournament is cloned with the build function
+// (see [CloneTournament]). The score is applied to the
+// cloned match and the clone is updated. Then the match slots,
+// the rankings and the blocking ties of the clone are compared
+// to the original tournament.
+func PreviewResult[T Tournament](
+	tournament T,
+	build func() (T, error),
+	match *Match,
+	score Score,
+) (*ResultPreview, error) {
+	matchIndex := slices.Index(tournament.MatchList().Matches, match)
+	if matchIndex == -1 {
+		return nil, ErrMatchNotInTournament
+	}
+	if !match.AllowDraw && isDrawScore(score) {
+		return nil, ErrDrawNotAllowed
+	}
+
+	clone, err := CloneTournament(tournament, build)
+	if err != nil {
+		return nil, err
+	}
+
+	rankings := rankingsOfTournament(tournament)
+	cloneRankings := rankingsOfTournament(clone)
+	if len(rankings) != len(cloneRankings) {
+		return nil, ErrCloneMismatch
+	}
+
+	clone.MatchList().Matches[matchIndex].Score = score
+	updateUntilStable(clone)
+
+	preview := &ResultPreview{
+		SlotChanges: diffMatchSlots(
+			tournament.MatchList().Matches,
+			clone.MatchList().Matches,
+		),
+		RankingChanges:  make([]*RankingChange, 0),
+		NewBlockingTies: make([]*BlockingTie, 0),
+	}
+
+	for i, ranking := range rankings {
+		before := rankedPlayers(ranking)
+		after := rankedPlayers(cloneRankings[i])
+		rankingChanged := !slices.EqualFunc(before, after, func(b, a []Player) bool {
+			return slices.Equal(b, a)
+		})
+		if rankingChanged {
+			preview.RankingChanges = append(preview.RankingChanges, &RankingChange{
+				Ranking: ranking,
+				Before:  before,
+				After:   after,
+			})
+		}
+
+		tiesBefore := blockingTiesOfRanking(ranking)
+		for _, tie := range blockingTiesOfRanking(cloneRankings[i]) {
+			existing := slices.ContainsFunc(tiesBefore, func(t []Player) bool { return slices.Equal(t, tie) })
+			if !existing {
+				preview.NewBlockingTies = append(preview.NewBlockingTies, &BlockingTie{
+					Ranking: ranking,
+					Players: tie,
+				})
+			}
+		}
+	}
+
+	return preview, nil
+}
+
+// Returns the changed occupants of the source matches
+// compared to the target matches
+func diffMatchSlots(source, target []*Match) []*SlotChange {
+	changes := make([]*SlotChange, 0)
+	for i, m := range source {
+		before := [2]Player{m.Slot1.Player, m.Slot2.Player}
+		after := [2]Player{target[i].Slot1.Player, target[i].Slot2.Player}
+		for j := range 2 {
+			if before[j] != after[j] {
+				changes = append(changes, &SlotChange{
+					Match:      m,
+					SlotNumber: j + 1,
+					Before:     before[j],
+					After:      after[j],
+				})
+			}
+		}
+	}
+	return changes
+}
+
+// Returns the rankings of the tournament ordered by their ID
+func rankingsOfTournament(tournament Tournament) []Ranking {
+	withGraph, ok := tournament.(interface{ rankingGraph() *RankingGraph })
+	if !ok {
+		return nil
+	}
+	return withGraph.rankingGraph().Nodes()
+}
+
+// Returns the occupied ranks of the ranking with
+// the tied players sharing a rank
+func rankedPlayers(ranking Ranking) [][]Player {
+	ranks := make([][]Player, 0)
+
+	tieable, ok := ranking.(TieableRanking)
+	if !ok {
+		for _, p := range playersOfSlots(ranking.Ranks()) {
+			ranks = append(ranks, []Player{p})
+		}
+		return ranks
+	}
+
+	for _, tie := range tieable.TiedRanks() {
+		players := playersOfSlots(tie)
+		if len(players) > 0 {
+			ranks = append(ranks, players)
+		}
+	}
+	return ranks
+}
+
+// Returns the players of the blocking ties in the ranking.
+// Ties with empty slots are left out since they can not
+// be broken yet.
+func blockingTiesOfRanking(ranking Ranking) [][]Player {
+	tieable, ok := ranking.(TieableRanking)
+	if !ok {
+		return nil
+	}
+	withQualifications, ok := ranking.(interface{ requiredUntiedRanks() int })
+	if !ok {
+		return nil
+	}
+
+	ties := make([][]Player, 0)
+	for _, tie := range tieable.BlockingTies(withQualifications.requiredUntiedRanks()) {
+		hasEmptySlot := slices.ContainsFunc(tie, func(s *Slot) bool { return s.Player == nil })
+		if !hasEmptySlot {
+			ties = append(ties, playersOfSlots(tie))
+		}
+	}
+	return ties
+}
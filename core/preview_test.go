@@ -0,0 +1,89 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPreviewResult(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	build := func() (*SingleElimination, error) { return NewSingleElimination(entries) }
+	tournament, _ := build()
+
+	semi := tournament.Matches[0]
+	final := tournament.Matches[2]
+
+	other, _ := NewSingleElimination(entries)
+	_, err = PreviewResult(tournament, build, other.Matches[0], NewScore(21, 10))
+	if err != ErrMatchNotInTournament {
+		t.Fatal("A match of another tournament was previewed")
+	}
+
+	preview, err := PreviewResult(tournament, build, semi, NewScore(21, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if semi.Score != nil || final.Slot1.Player != nil {
+		t.Fatal("The preview changed the tournament")
+	}
+
+	winner := semi.Slot1.Player
+	eq1 := len(preview.SlotChanges) == 1 && preview.SlotChanges[0].Match == final
+	eq2 := slices.Equal(preview.Advancing(), []Player{winner})
+	if !eq1 || !eq2 {
+		t.Fatal("The preview did not show the winner advancing")
+	}
+
+	changed := slices.ContainsFunc(preview.RankingChanges, func(c *RankingChange) bool {
+		return c.Ranking == tournament.FinalRanking
+	})
+	if !changed {
+		t.Fatal("The preview did not show the change of the final ranking")
+	}
+}
+
+func TestPreviewBlockingTie(t *testing.T) {
+	players, err := PlayerSlice(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	build := func() (*GroupPhase, error) {
+		return newGroupPhase(entries, 3, 5, NewScore(1, 0), NewRankingGraph(entries)), nil
+	}
+	tournament, _ := build()
+
+	ml := tournament.matchList
+	for _, m := range ml.Matches {
+		m.StartMatch()
+		m.EndMatch(NewScore(2, 0))
+	}
+	ml.Matches[1].Score = NewScore(3, 0)
+	tournament.Update(nil)
+
+	if len(tournament.FinalRanking.CrossGroupTies()) != 0 {
+		t.Fatal("The tournament has a blocking tie before the preview")
+	}
+
+	preview, err := PreviewResult(tournament, build, ml.Matches[1], NewScore(2, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := slices.IndexFunc(preview.NewBlockingTies, func(tie *BlockingTie) bool {
+		return tie.Ranking == tournament.FinalRanking
+	})
+	if i == -1 || len(preview.NewBlockingTies[i].Players) != 3 {
+		t.Fatal("The preview did not show the new cross group tie")
+	}
+	if len(tournament.FinalRanking.CrossGroupTies()) != 0 {
+		t.Fatal("The preview changed the tournament")
+	}
+}
@@ -253,6 +253,10 @@ func (r *BaseTieableRanking) applyTieBreakers(tiedRanks [][]*Slot) [][]*Slot {
 	return tieBrokenRanks
 }
 
+func (r *BaseTieableRanking) requiredUntiedRanks() int {
+	return r.RequiredUntiedRanks
+}
+
 func (r *BaseTieableRanking) String() string {
 	var sb strings.Builder
 
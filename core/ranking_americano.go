@@ -0,0 +1,101 @@
+package core
+
+import "slices"
+
+// The AmericanoRanking ranks the individual players of an
+// Americano tournament by the total points that they won
+// in all their matches and then by their number of wins.
+//
+// The metrics of each match are counted for both members
+// of the pairs that played it.
+type AmericanoRanking struct {
+	BaseTieableRanking
+
+	// Each individual player's metrics which are the basis for the ranks.
+	// The metrics are updated in the updateRanks call
+	Metrics map[Player]*MatchMetrics
+
+	// Configures how the metrics are extracted from the matches
+	MetricSettings MetricSettings
+
+	entrySlots []*Slot
+	players    []Player
+
+	metricSource *baseMatchMetricSource
+}
+
+func (r *AmericanoRanking) updateRanks() {
+	// The players are read again because an entry
+	// might have been replaced or disqualified
+	disqualified := r.metricSource.disqualifiedPlayers()
+	r.players = slices.DeleteFunc(playersOfSlots(r.entrySlots), func(p Player) bool {
+		return slices.Contains(disqualified, p)
+	})
+
+	pairMetrics := r.metricSource.CreateMetrics(nil, r.MetricSettings)
+
+	metrics := make(map[Player]*MatchMetrics)
+	addZeroMetrics(metrics, r.players)
+
+	for pair, m := range pairMetrics {
+		composite, ok := pair.(CompositePlayer)
+		if !ok {
+			continue
+		}
+		for _, member := range composite.Members() {
+			if memberMetrics, ok := metrics[member]; ok {
+				memberMetrics.Add(m)
+			}
+		}
+	}
+
+	r.Metrics = metrics
+
+	sortedByPoints := sortByMetric(r.players, metrics, func(m *MatchMetrics) int { return m.PointWins })
+
+	ranks := make([][]*Slot, 0, len(r.players))
+	for _, tie := range sortedByPoints {
+		sortedByWins := sortByMetric(tie, metrics, func(m *MatchMetrics) int { return m.Wins })
+		for _, subTie := range sortedByWins {
+			tiedSlots := make([]*Slot, 0, len(subTie))
+			for _, p := range subTie {
+				i := slotIndexOfPlayer(r.entrySlots, p)
+				tiedSlots = append(tiedSlots, r.entrySlots[i])
+			}
+			ranks = append(ranks, tiedSlots)
+		}
+	}
+
+	r.ProcessUpdate(ranks)
+}
+
+func NewAmericanoRanking(
+	entries Ranking,
+	matches []*Match,
+	walkoverScore Score,
+	rankingGraph *RankingGraph,
+) *AmericanoRanking {
+	entrySlots := make([]*Slot, 0, len(entries.Ranks()))
+	for _, s := range entries.Ranks() {
+		if s.Player != nil {
+			entrySlots = append(entrySlots, s)
+		}
+	}
+
+	ranking := &AmericanoRanking{
+		BaseTieableRanking: NewBaseTieableRanking(0),
+		entrySlots:         entrySlots,
+		metricSource: &baseMatchMetricSource{
+			matches:       matches,
+			walkoverScore: walkoverScore,
+		},
+	}
+	ranking.updateRanks()
+
+	if rankingGraph != nil {
+		rankingGraph.AddVertex(ranking)
+		rankingGraph.AddEdge(entries, ranking)
+	}
+
+	return ranking
+}
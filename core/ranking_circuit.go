@@ -0,0 +1,205 @@
+package core
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrUnknownTier = errors.New("the tier is not configured in the circuit")
+)
+
+// A CircuitPointsTable maps the final place in a tournament
+// to the circuit points that are awarded for it.
+// Index 0 holds the points for the winner, index 1 for the
+// runner-up and so on. Places beyond the end of the table
+// are awarded no points.
+type CircuitPointsTable []int
+
+// Returns the points that are awarded for the given place (0-based)
+func (t CircuitPointsTable) Points(place int) int {
+	if place < 0 || place >= len(t) {
+		return 0
+	}
+	return t[place]
+}
+
+// The points that a player was awarded in one tournament
+// of the circuit
+type CircuitResult struct {
+	// The tier of the tournament
+	Tier string
+	// The final place of the player (0-based).
+	// Tied players share the best place of their tie.
+	Place int
+	// The awarded circuit points
+	Points int
+}
+
+// A CircuitStanding is the aggregated result of one player
+// across all tournaments of a circuit.
+type CircuitStanding struct {
+	Player Player
+
+	// The sum of the counted results
+	Points int
+
+	// All results of the player in descending order of points
+	Results []*CircuitResult
+
+	// The number of results (from the start of Results)
+	// that count towards the points
+	NumCounted int
+}
+
+// A CircuitRanking aggregates the final rankings of multiple
+// tournaments into one ranking for a tournament circuit.
+//
+// Each tournament belongs to a tier (e.g. "International Series"
+// or "Grand Prix") which determines the points table that is
+// applied to its final ranking. Only the best results of each
+// player count towards their circuit points.
+type CircuitRanking struct {
+	// The points tables of the circuit by tier name
+	Tiers map[string]CircuitPointsTable
+
+	// The number of best results of each player that count
+	// towards their points. All results count when this is 0.
+	BestOf int
+
+	standings map[string]*CircuitStanding
+	order     []string
+}
+
+// Adds the final ranking of a tournament of the given tier to the circuit.
+//
+// The ranks are read from TiedRanks at the time of the call so the
+// tournament should be complete. Players who are tied all get the
+// points of the best place of their tie (e.g. both losing semi-finalists
+// get the points for 3rd place). Bye slots and empty slots still
+// occupy their place but do not get any points.
+func (r *CircuitRanking) AddResult(tier string, ranking TieableRanking) error {
+	table, ok := r.Tiers[tier]
+	if !ok {
+		return ErrUnknownTier
+	}
+
+	place := 0
+	for _, tie := range ranking.TiedRanks() {
+		for _, slot := range tie {
+			if slot.Player == nil || slot.IsBye() {
+				continue
+			}
+			result := &CircuitResult{
+				Tier:   tier,
+				Place:  place,
+				Points: table.Points(place),
+			}
+			r.addPlayerResult(slot.Player, result)
+		}
+		place += len(tie)
+	}
+
+	return nil
+}
+
+func (r *CircuitRanking) addPlayerResult(player Player, result *CircuitResult) {
+	if r.standings == nil {
+		r.standings = make(map[string]*CircuitStanding)
+	}
+
+	standing, ok := r.standings[player.Id()]
+	if !ok {
+		standing = &CircuitStanding{Player: player}
+		r.standings[player.Id()] = standing
+		r.order = append(r.order, player.Id())
+	}
+
+	standing.Results = append(standing.Results, result)
+	slices.SortStableFunc(standing.Results, func(a, b *CircuitResult) int {
+		return b.Points - a.Points
+	})
+
+	standing.NumCounted = len(standing.Results)
+	if r.BestOf > 0 {
+		standing.NumCounted = min(standing.NumCounted, r.BestOf)
+	}
+
+	standing.Points = 0
+	for _, result := range standing.Results[:standing.NumCounted] {
+		standing.Points += result.Points
+	}
+}
+
+// Returns the standings of all players who have at least
+// one result in the circuit ordered by their points.
+//
+// The tied standings are in the same nested slice. A tie
+// of points is broken by comparing the counted results
+// from best to worst (more points in the best result wins,
+// then the second best, etc.).
+func (r *CircuitRanking) TiedStandings() [][]*CircuitStanding {
+	standings := make([]*CircuitStanding, 0, len(r.order))
+	for _, id := range r.order {
+		standings = append(standings, r.standings[id])
+	}
+
+	slices.SortStableFunc(standings, compareStandings)
+
+	tiedStandings := make([][]*CircuitStanding, 0, len(standings))
+	for i, s := range standings {
+		if i > 0 && compareStandings(standings[i-1], s) == 0 {
+			last := len(tiedStandings) - 1
+			tiedStandings[last] = append(tiedStandings[last], s)
+		} else {
+			tiedStandings = append(tiedStandings, []*CircuitStanding{s})
+		}
+	}
+
+	return tiedStandings
+}
+
+// Returns the same standings as TiedStandings but flattened
+func (r *CircuitRanking) Standings() []*CircuitStanding {
+	tiedStandings := r.TiedStandings()
+	standings := make([]*CircuitStanding, 0, len(r.order))
+	for _, tie := range tiedStandings {
+		standings = append(standings, tie...)
+	}
+	return standings
+}
+
+// Returns the standing of the given player or nil if the
+// player has no results in the circuit
+func (r *CircuitRanking) Standing(player Player) *CircuitStanding {
+	return r.standings[player.Id()]
+}
+
+// Compares two standings by their points and then
+// by their counted results. Better standings are sorted
+// to the front.
+func compareStandings(a, b *CircuitStanding) int {
+	if a.Points != b.Points {
+		return b.Points - a.Points
+	}
+
+	numCompared := min(a.NumCounted, b.NumCounted)
+	for i := range numCompared {
+		pointsA := a.Results[i].Points
+		pointsB := b.Results[i].Points
+		if pointsA != pointsB {
+			return pointsB - pointsA
+		}
+	}
+
+	return 0
+}
+
+func NewCircuitRanking(tiers map[string]CircuitPointsTable, bestOf int) *CircuitRanking {
+	ranking := &CircuitRanking{
+		Tiers:     tiers,
+		BestOf:    bestOf,
+		standings: make(map[string]*CircuitStanding),
+	}
+	return ranking
+}
@@ -0,0 +1,118 @@
+package core
+
+import "testing"
+
+func playSingleElimination(tournament *SingleElimination, winners []int) {
+	for i, m := range tournament.matchList.Matches {
+		m.StartMatch()
+		if winners[i] == 0 {
+			m.EndMatch(NewScore(1, 0))
+		} else {
+			m.EndMatch(NewScore(0, 1))
+		}
+		tournament.Update(nil)
+	}
+}
+
+func TestCircuitRanking(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+	p3 := players[2]
+	p4 := players[3]
+
+	tiers := map[string]CircuitPointsTable{
+		"major": {100, 70, 50, 50},
+		"minor": {40, 30, 20},
+	}
+	circuit := NewCircuitRanking(tiers, 2)
+
+	err = circuit.AddResult("unknown", nil)
+	if err != ErrUnknownTier {
+		t.Fatal("Adding a result of an unknown tier did not error")
+	}
+
+	// p1 wins, p2 runner-up, p3 and p4 tied 3rd
+	major, _ := NewSingleElimination(NewConstantRanking(players))
+	playSingleElimination(major, []int{0, 0, 0})
+	circuit.AddResult("major", major.FinalRanking)
+
+	eq1 := circuit.Standing(p1).Points == 100
+	eq2 := circuit.Standing(p2).Points == 70
+	eq3 := circuit.Standing(p3).Points == 50 && circuit.Standing(p4).Points == 50
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("The points of the first tournament were not awarded correctly")
+	}
+
+	// p4 wins, p2 runner-up, p1 and p3 tied 3rd
+	minor, _ := NewSingleElimination(NewConstantRanking(players))
+	playSingleElimination(minor, []int{1, 0, 0})
+	circuit.AddResult("minor", minor.FinalRanking)
+
+	minor, _ = NewSingleElimination(NewConstantRanking(players))
+	playSingleElimination(minor, []int{1, 0, 0})
+	circuit.AddResult("minor", minor.FinalRanking)
+
+	standing4 := circuit.Standing(p4)
+	eq1 = standing4.Points == 90 && standing4.NumCounted == 2
+	eq2 = len(standing4.Results) == 3 && standing4.Results[2].Points == 40
+	if !eq1 || !eq2 {
+		t.Fatal("Only the best results should count towards the circuit points")
+	}
+
+	standing1 := circuit.Standing(p1)
+	eq1 = standing1.Points == 120
+	if !eq1 {
+		t.Fatal("The tied 3rd place did not get the points of the best place in the tie")
+	}
+
+	standings := circuit.TiedStandings()
+	eq1 = len(standings) == 4
+	eq2 = standings[0][0].Player == p1
+	eq3 = len(standings[1]) == 1 && standings[1][0].Player == p2
+	eq4 := standings[2][0].Player == p4 && standings[3][0].Player == p3
+	if !eq1 || !eq2 || !eq3 || !eq4 {
+		t.Fatal("The circuit standings are not in the expected order")
+	}
+
+	// p2 has 70+30=100, p4 has 50+40=90, p3 has 50+20=70
+	eq1 = circuit.Standing(p2).Points == 100
+	eq2 = circuit.Standing(p3).Points == 70
+	if !eq1 || !eq2 {
+		t.Fatal("The circuit points are not aggregated correctly")
+	}
+}
+
+func TestCircuitStandingTieBreak(t *testing.T) {
+	players, err := PlayerSlice(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &CircuitStanding{
+		Player:     players[0],
+		Points:     60,
+		Results:    []*CircuitResult{{Points: 40}, {Points: 20}},
+		NumCounted: 2,
+	}
+	b := &CircuitStanding{
+		Player:     players[1],
+		Points:     60,
+		Results:    []*CircuitResult{{Points: 30}, {Points: 30}},
+		NumCounted: 2,
+	}
+
+	if compareStandings(a, b) >= 0 {
+		t.Fatal("The standing with the better best result was not ranked higher")
+	}
+
+	b.Results[0].Points = 40
+	b.Results[1].Points = 20
+	if compareStandings(a, b) != 0 {
+		t.Fatal("Standings with equal results are not tied")
+	}
+}
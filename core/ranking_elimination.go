@@ -54,6 +54,7 @@ func (r *EliminationRanking) updateRanks() {
 	ranks = append(ranks, occupiedEntrySlots)
 
 	ranks = RemoveDoubleRanks(ranks)
+	ranks = removeDisqualified(ranks, DisqualifiedPlayers(r.MatchList.Matches))
 
 	r.ProcessUpdate(ranks)
 }
@@ -0,0 +1,126 @@
+package core
+
+import "slices"
+
+// A LadderChange records the move of a player on the
+// ladder as the result of a challenge
+type LadderChange struct {
+	Challenge *Challenge
+	Player    Player
+
+	// The positions before and after the challenge
+	// (0 is the top of the ladder)
+	From, To int
+}
+
+// The LadderRanking is the current order of a ladder.
+//
+// The order is determined by replaying the completed challenges
+// and the withdrawals in the order that they happened on top of
+// the entries. A challenger who wins swaps positions with the
+// defender. Withdrawn players are moved to the bottom of the ladder
+// and stay there when they reenter. Disqualified players are
+// removed from the ladder.
+type LadderRanking struct {
+	BaseRanking
+
+	// The position changes of all completed challenges
+	// in the order of the challenges.
+	// The history is updated in the updateRanks call
+	History []LadderChange
+
+	entrySlots   []*Slot
+	challenges   []*Challenge
+	withdrawn    []Player
+	withdrawals  []ladderWithdrawal
+	disqualified []Player
+}
+
+// A withdrawal from the ladder that moved the player to the bottom
+type ladderWithdrawal struct {
+	player Player
+	// The number of challenges that were issued before the withdrawal
+	afterChallenges int
+}
+
+func (r *LadderRanking) updateRanks() {
+	ranks := slices.Clone(r.entrySlots)
+	history := make([]LadderChange, 0, 2*len(r.challenges))
+
+	moveWithdrawals := func(numChallenges int) {
+		for _, w := range r.withdrawals {
+			pos := slotIndexOfPlayer(ranks, w.player)
+			if w.afterChallenges != numChallenges || pos == -1 {
+				continue
+			}
+			slot := ranks[pos]
+			ranks = append(slices.Delete(ranks, pos, pos+1), slot)
+		}
+	}
+
+	for i, c := range r.challenges {
+		moveWithdrawals(i)
+
+		winner, err := c.Match.GetWinner()
+		if err != nil || winner.Player != c.Challenger {
+			continue
+		}
+
+		challengerPos := slotIndexOfPlayer(ranks, c.Challenger)
+		defenderPos := slotIndexOfPlayer(ranks, c.Defender)
+		if challengerPos < defenderPos {
+			continue
+		}
+
+		ranks[challengerPos], ranks[defenderPos] = ranks[defenderPos], ranks[challengerPos]
+
+		history = append(
+			history,
+			LadderChange{Challenge: c, Player: c.Challenger, From: challengerPos, To: defenderPos},
+			LadderChange{Challenge: c, Player: c.Defender, From: defenderPos, To: challengerPos},
+		)
+	}
+	moveWithdrawals(len(r.challenges))
+
+	r.ranks = slices.DeleteFunc(ranks, func(s *Slot) bool {
+		return slices.Contains(r.disqualified, s.Player)
+	})
+	r.History = history
+}
+
+// Returns the position of the player on the ladder
+// or -1 if the player is not on the ladder
+func (r *LadderRanking) Position(player Player) int {
+	return slotIndexOfPlayer(r.ranks, player)
+}
+
+// Returns the players who were disqualified from the ladder
+func (r *LadderRanking) disqualifiedPlayers() []Player {
+	return r.disqualified
+}
+
+func slotIndexOfPlayer(slots []*Slot, player Player) int {
+	return slices.IndexFunc(slots, func(s *Slot) bool { return s.Player == player })
+}
+
+func NewLadderRanking(entries Ranking, rankingGraph *RankingGraph) *LadderRanking {
+	entrySlots := make([]*Slot, 0, len(entries.Ranks()))
+	for _, s := range entries.Ranks() {
+		if s.Player != nil {
+			entrySlots = append(entrySlots, s)
+		}
+	}
+
+	ranking := &LadderRanking{
+		BaseRanking: NewBaseRanking(),
+		entrySlots:  entrySlots,
+	}
+	ranking.updateRanks()
+
+	if rankingGraph != nil {
+		rankingGraph.AddVertex(ranking)
+		rankingGraph.AddEdge(entries, ranking)
+	}
+
+	return ranking
+}
@@ -15,31 +15,119 @@ type MatchMetricRanking struct {
 	// The metrics are updated in the updateRanks call
 	Metrics map[Player]*MatchMetrics
 
+	// When set, the players are ranked by the points that the
+	// table awards for their match outcomes instead of their wins
+	PointsTable *PointsTable
+
+	// Configures how the metrics are extracted from the matches
+	MetricSettings MetricSettings
+
 	entrySlots []*Slot
 	players    []Player
 
 	metricSource matchMetricSource
 }
 
+// A PointsTable awards ranking points for the
+// outcomes of matches (e.g. 3 for a win, 1 for a draw
+// and 0 for a loss).
+//
+// Walkovers can be awarded their own points which allows
+// to distinguish a loss on court from a no-show
+// (see [PointsTable.WithWalkoverPoints]). When the walkover
+// points are nil, walkovers are awarded like regular wins
+// and losses.
+type PointsTable struct {
+	Win, Draw, Loss int
+
+	WalkoverWin, WalkoverLoss *int
+}
+
+// Creates a PointsTable that awards the same points
+// for walkovers as for regular wins and losses
+func NewPointsTable(win, draw, loss int) PointsTable {
+	return PointsTable{
+		Win:  win,
+		Draw: draw,
+		Loss: loss,
+	}
+}
+
+// Returns a copy of the table that awards the given
+// points for walkovers
+func (t PointsTable) WithWalkoverPoints(win, loss int) PointsTable {
+	t.WalkoverWin = &win
+	t.WalkoverLoss = &loss
+	return t
+}
+
+// Returns the sum of points that the table awards
+// for the outcomes in the given metrics
+func (t *PointsTable) Points(m *MatchMetrics) int {
+	walkoverWin := t.Win
+	if t.WalkoverWin != nil {
+		walkoverWin = *t.WalkoverWin
+	}
+	walkoverLoss := t.Loss
+	if t.WalkoverLoss != nil {
+		walkoverLoss = *t.WalkoverLoss
+	}
+
+	regularWins := m.Wins - m.WalkoverWins
+	regularLosses := m.Losses - m.WalkoverLosses
+
+	points := regularWins*t.Win + m.Draws*t.Draw + regularLosses*t.Loss
+	points += m.WalkoverWins*walkoverWin + m.WalkoverLosses*walkoverLoss
+
+	return points
+}
+
+// Returns the metric that the players are primarily ranked by.
+// That is the number of wins or the points according
+// to the PointsTable.
+func (r *MatchMetricRanking) primaryMetric(m *MatchMetrics) int {
+	if r.PointsTable == nil {
+		return m.Wins
+	}
+	return r.PointsTable.Points(m)
+}
+
 type matchMetricSource interface {
 	// Creates a MatchMetrics struct for each player in a set of matches.
 	// If the players slice is not nil/empty only the matches where both
 	// opponents are in the slice are counted.
 	CreateMetrics(
 		players []Player,
+		settings MetricSettings,
 	) map[Player]*MatchMetrics
+
+	// Returns the players who were disqualified in the matches
+	disqualifiedPlayers() []Player
 }
 
 func (r *MatchMetricRanking) updateRanks() {
-	metrics := r.metricSource.CreateMetrics(nil)
+	// The players are read again because an entry
+	// might have been replaced or disqualified
+	disqualified := r.metricSource.disqualifiedPlayers()
+	r.players = slices.DeleteFunc(playersOfSlots(r.entrySlots), func(p Player) bool {
+		return slices.Contains(disqualified, p)
+	})
+
+	metrics := r.metricSource.CreateMetrics(nil, r.MetricSettings)
 	addZeroMetrics(metrics, r.players)
 
+	if r.PointsTable != nil {
+		for _, m := range metrics {
+			m.Points = r.PointsTable.Points(m)
+		}
+	}
+
 	r.Metrics = metrics
 
-	sortedByWins := sortByMetric(r.players, metrics, func(m *MatchMetrics) int { return m.Wins })
+	sortedByPrimary := sortByMetric(r.players, metrics, r.primaryMetric)
 
-	tieBroken := make([][]Player, 0, len(sortedByWins)+5)
-	for _, tie := range sortedByWins {
+	tieBroken := make([][]Player, 0, len(sortedByPrimary)+5)
+	for _, tie := range sortedByPrimary {
 		broken := r.breakTie(tie)
 		tieBroken = append(tieBroken, broken...)
 	}
@@ -48,7 +136,7 @@ func (r *MatchMetricRanking) updateRanks() {
 	for _, tie := range tieBroken {
 		tiedSlots := make([]*Slot, 0, len(tie))
 		for _, p := range tie {
-			i := slices.Index(r.players, p)
+			i := slotIndexOfPlayer(r.entrySlots, p)
 			tiedSlots = append(tiedSlots, r.entrySlots[i])
 		}
 		ranks = append(ranks, tiedSlots)
@@ -57,10 +145,12 @@ func (r *MatchMetricRanking) updateRanks() {
 	r.ProcessUpdate(ranks)
 }
 
-// Attempts to break the tie between players with the same amount of wins.
+// Attempts to break the tie between players with the same amount of wins
+// (or points when a PointsTable is set).
 //
 // The tie-break operates in this order:
 //   - If the tie has only 2 players it is forwarded to breakTwoWayTie
+//   - Who won more rubbers in all their matches (only for composite scores)
 //   - Who won more sets in all their matches (according to stats)
 //   - If that yields smaller ties they are recursively broken by breakTie
 //   - Who won more points in all their matches
@@ -79,16 +169,14 @@ func (r *MatchMetricRanking) breakTie(tie []Player) [][]Player {
 		return r.breakTwoWayTie(tie[0], tie[1])
 	}
 
-	sortedBySets := sortByMetric(tie, metrics, func(m *MatchMetrics) int { return m.SetDifference })
+	sortedByRubbers := sortByMetric(tie, metrics, func(m *MatchMetrics) int { return m.RubberDifference })
+	if len(sortedByRubbers) > 1 {
+		return r.breakSubTies(sortedByRubbers)
+	}
 
+	sortedBySets := sortByMetric(tie, metrics, func(m *MatchMetrics) int { return m.SetDifference })
 	if len(sortedBySets) > 1 {
-		// Break emerged sub-ties
-		subTieBroken := make([][]Player, 0, 5)
-		for _, subTie := range sortedBySets {
-			broken := r.breakTie(subTie)
-			subTieBroken = append(subTieBroken, broken...)
-		}
-		return subTieBroken
+		return r.breakSubTies(sortedBySets)
 	}
 
 	sortedByPoints := sortByMetric(tie, metrics, func(m *MatchMetrics) int { return m.PointDifference })
@@ -107,15 +195,27 @@ func (r *MatchMetricRanking) breakTie(tie []Player) [][]Player {
 	return subTieBroken
 }
 
+// Breaks the ties that emerged from sorting a bigger tie
+func (r *MatchMetricRanking) breakSubTies(subTies [][]Player) [][]Player {
+	subTieBroken := make([][]Player, 0, 5)
+	for _, subTie := range subTies {
+		broken := r.breakTie(subTie)
+		subTieBroken = append(subTieBroken, broken...)
+	}
+	return subTieBroken
+}
+
 // Attempts to break a two-way-tie between p1 and p2.
 //
 // The tie-break operates in this order:
 //
 // Who won more...
-//   - direct encounters (inside matches)
+//   - direct encounters (inside matches, by points when a PointsTable is set)
+//   - rubbers in the direct encounters (only for composite scores)
 //   - sets in the direct encounters
 //   - points in the direct encounters
-//   - sets in all their matches (according to the metrics)
+//   - rubbers in all their matches (according to the metrics)
+//   - sets in all their matches
 //   - points in all their matches
 //
 // If none of those criteria are decisive the tie is unbreakable and
@@ -123,10 +223,15 @@ func (r *MatchMetricRanking) breakTie(tie []Player) [][]Player {
 func (r *MatchMetricRanking) breakTwoWayTie(p1, p2 Player) [][]Player {
 	metrics := r.Metrics
 	tie := []Player{p1, p2}
-	directMetrics := r.metricSource.CreateMetrics(tie)
+	directMetrics := r.metricSource.CreateMetrics(tie, r.MetricSettings)
 	addZeroMetrics(directMetrics, tie)
 
-	metricSorted := sortByMetric(tie, directMetrics, func(m *MatchMetrics) int { return m.Wins })
+	metricSorted := sortByMetric(tie, directMetrics, r.primaryMetric)
+	if len(metricSorted) == 2 {
+		return metricSorted
+	}
+
+	metricSorted = sortByMetric(tie, directMetrics, func(m *MatchMetrics) int { return m.RubberDifference })
 	if len(metricSorted) == 2 {
 		return metricSorted
 	}
@@ -141,6 +246,11 @@ func (r *MatchMetricRanking) breakTwoWayTie(p1, p2 Player) [][]Player {
 		return metricSorted
 	}
 
+	metricSorted = sortByMetric(tie, metrics, func(m *MatchMetrics) int { return m.RubberDifference })
+	if len(metricSorted) == 2 {
+		return metricSorted
+	}
+
 	metricSorted = sortByMetric(tie, metrics, func(m *MatchMetrics) int { return m.SetDifference })
 	if len(metricSorted) == 2 {
 		return metricSorted
@@ -178,26 +288,30 @@ func sortByMetric(players []Player, metrics map[Player]*MatchMetrics, getter fun
 	return sortedPlayers
 }
 
+// Returns a copy of this ranking that gets its metrics from the given
+// matches instead. The copy keeps the settings and tie breakers
+// and is not part of any ranking graph.
+// Only rankings with a base metric source can be copied this way.
+func (r *MatchMetricRanking) withMatches(matches []*Match) *MatchMetricRanking {
+	source := *r.metricSource.(*baseMatchMetricSource)
+	source.matches = matches
+
+	ranking := *r
+	ranking.metricSource = &source
+	ranking.updateRanks()
+
+	return &ranking
+}
+
 func createMatchMetricRanking(
 	entries Ranking,
 	metricSource matchMetricSource,
 	requiredUntiedRanks int,
 	rankingGraph *RankingGraph,
 ) *MatchMetricRanking {
-	entrySlots := entries.Ranks()
-	players := make([]Player, 0, len(entrySlots))
-
-	for _, s := range entrySlots {
-		player := s.Player
-		if player != nil {
-			players = append(players, player)
-		}
-	}
-
 	ranking := &MatchMetricRanking{
 		BaseTieableRanking: NewBaseTieableRanking(requiredUntiedRanks),
-		entrySlots:         entrySlots,
-		players:            players,
+		entrySlots:         entries.Ranks(),
 		metricSource:       metricSource,
 	}
 	ranking.updateRanks()
@@ -210,6 +324,17 @@ func createMatchMetricRanking(
 	return ranking
 }
 
+// Returns the players in the slots that are occupied
+func playersOfSlots(slots []*Slot) []Player {
+	players := make([]Player, 0, len(slots))
+	for _, s := range slots {
+		if s.Player != nil {
+			players = append(players, s.Player)
+		}
+	}
+	return players
+}
+
 func NewRoundRobinRanking(
 	entries Ranking,
 	matches []*Match,
@@ -229,6 +354,22 @@ func NewRoundRobinRanking(
 	)
 }
 
+// Creates a ranking like NewRoundRobinRanking that ranks
+// the players by the points that the table awards for
+// their match outcomes (a league table).
+func NewPointsTableRanking(
+	entries Ranking,
+	matches []*Match,
+	walkoverScore Score,
+	pointsTable PointsTable,
+	rankingGraph *RankingGraph,
+) *MatchMetricRanking {
+	ranking := NewRoundRobinRanking(entries, matches, walkoverScore, rankingGraph)
+	ranking.PointsTable = &pointsTable
+	ranking.updateRanks()
+	return ranking
+}
+
 func NewCrossGroupRanking(
 	entries Ranking,
 	groups []*RoundRobin,
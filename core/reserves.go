@@ -0,0 +1,157 @@
+package core
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrNoReserveOffer   = errors.New("there is no reserve offer for the player")
+	ErrReserveEntered   = errors.New("the reserve is already entered in the tournament")
+	ErrReserveOfferGone = errors.New("the withdrawn player reentered the tournament")
+)
+
+// The ReserveList holds the ordered alternates of a tournament.
+//
+// When a player withdraws before any of their matches started,
+// the first reserve is offered to replace them. With AutoFill the
+// offer is confirmed right away. Otherwise it waits for a call
+// to ConfirmReserve or DeclineReserve.
+type ReserveList struct {
+	// The alternates in the order that they are offered
+	Players []Player
+
+	// When true, offers are confirmed without manual confirmation
+	AutoFill bool
+
+	offers []*ReserveOffer
+}
+
+// A ReserveOffer proposes a reserve as the
+// replacement of a withdrawn player
+type ReserveOffer struct {
+	Withdrawn, Reserve Player
+}
+
+// Returns the offers that await a confirmation
+func (l *ReserveList) Offers() []*ReserveOffer {
+	return slices.Clone(l.offers)
+}
+
+// Returns the offer for the withdrawn player or nil
+func (l *ReserveList) offerOf(withdrawn Player) *ReserveOffer {
+	i := slices.IndexFunc(l.offers, func(o *ReserveOffer) bool { return o.Withdrawn == withdrawn })
+	if i == -1 {
+		return nil
+	}
+	return l.offers[i]
+}
+
+func (l *ReserveList) removeOffer(offer *ReserveOffer) {
+	l.offers = slices.DeleteFunc(l.offers, func(o *ReserveOffer) bool { return o == offer })
+}
+
+// Returns the first reserve after the given one that is not
+// already offered to another withdrawn player.
+// Returns the first available reserve when after is nil.
+func (l *ReserveList) nextReserve(after Player) Player {
+	start := 0
+	if after != nil {
+		start = slices.Index(l.Players, after) + 1
+	}
+
+	for _, p := range l.Players[start:] {
+		offered := slices.ContainsFunc(l.offers, func(o *ReserveOffer) bool { return o.Reserve == p })
+		if !offered {
+			return p
+		}
+	}
+	return nil
+}
+
+// Withdraws the given player from the tournament.
+// The specific matches that the player was withdrawn from
+// are returned.
+//
+// When none of the player's matches have started, the next
+// reserve is offered as a replacement (see [ReserveList]).
+// With AutoFill, reserves that are already entered are skipped.
+// An offer that can not be confirmed for another reason stays
+// in Offers().
+func (t *BaseTournament[_]) WithdrawPlayer(player Player) []*Match {
+	withdrawMatches := t.WithdrawalPolicy.WithdrawPlayer(player)
+
+	beforePlay := len(withdrawMatches) > 0 && !MatchesStarted(t.MatchesOfPlayer(player)...)
+	if !beforePlay || t.Reserves.offerOf(player) != nil {
+		return withdrawMatches
+	}
+
+	reserve := t.Reserves.nextReserve(nil)
+	if reserve == nil {
+		return withdrawMatches
+	}
+
+	t.Reserves.offers = append(t.Reserves.offers, &ReserveOffer{Withdrawn: player, Reserve: reserve})
+
+	if t.Reserves.AutoFill {
+		for t.ConfirmReserve(player) == ErrReserveEntered {
+			if next, _ := t.DeclineReserve(player); next == nil {
+				break
+			}
+		}
+	}
+
+	return withdrawMatches
+}
+
+// Replaces the withdrawn player with the reserve that they were
+// offered and removes the reserve from the list.
+// The tournament is updated afterwards.
+func (t *BaseTournament[_]) ConfirmReserve(withdrawn Player) error {
+	offer := t.Reserves.offerOf(withdrawn)
+	if offer == nil {
+		return ErrNoReserveOffer
+	}
+
+	if len(t.ListReenterMatches(withdrawn)) == 0 {
+		t.Reserves.removeOffer(offer)
+		return ErrReserveOfferGone
+	}
+
+	_, err := t.ReplacePlayer(withdrawn, offer.Reserve)
+	if err == ErrAlreadyEntered {
+		err = ErrReserveEntered
+	}
+	if err != nil {
+		return err
+	}
+
+	t.Reserves.removeOffer(offer)
+	t.Reserves.Players = slices.DeleteFunc(t.Reserves.Players, func(p Player) bool { return p == offer.Reserve })
+
+	t.Update(nil)
+
+	return nil
+}
+
+// Declines the reserve that was offered to replace the withdrawn
+// player. The next reserve in the list is offered instead and
+// returned. Returns nil when no more reserves are available.
+func (t *BaseTournament[_]) DeclineReserve(withdrawn Player) (*ReserveOffer, error) {
+	offer := t.Reserves.offerOf(withdrawn)
+	if offer == nil {
+		return nil, ErrNoReserveOffer
+	}
+
+	t.Reserves.removeOffer(offer)
+
+	reserve := t.Reserves.nextReserve(offer.Reserve)
+	if reserve == nil {
+		return nil, nil
+	}
+
+	next := &ReserveOffer{Withdrawn: withdrawn, Reserve: reserve}
+	t.Reserves.offers = append(t.Reserves.offers, next)
+
+	return next, nil
+}
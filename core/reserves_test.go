@@ -0,0 +1,120 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestReserveOffers(t *testing.T) {
+	players, err := PlayerSlice(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players[:4])
+	tournament, _ := NewSingleElimination(entries)
+	tournament.Reserves.Players = []Player{players[4], players[5]}
+
+	semi := tournament.WithdrawPlayer(players[0])[0]
+	tournament.Update(nil)
+
+	offers := tournament.Reserves.Offers()
+	eq1 := len(offers) == 1 && offers[0].Withdrawn == players[0]
+	if !eq1 || offers[0].Reserve != players[4] {
+		t.Fatal("The first reserve was not offered")
+	}
+	if !semi.IsWalkover() {
+		t.Fatal("The reserve was entered without confirmation")
+	}
+
+	next, _ := tournament.DeclineReserve(players[0])
+	if next == nil || next.Reserve != players[5] {
+		t.Fatal("The next reserve was not offered after the decline")
+	}
+
+	err = tournament.ConfirmReserve(players[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eq1 = semi.Slot1.Player == players[5] && !semi.IsWalkover()
+	eq2 := slices.Equal(tournament.Reserves.Players, []Player{players[4]})
+	if !eq1 || !eq2 {
+		t.Fatal("The confirmed reserve did not replace the withdrawn player")
+	}
+
+	err = tournament.ConfirmReserve(players[0])
+	if err != ErrNoReserveOffer {
+		t.Fatal("A confirmed offer was confirmed again")
+	}
+
+	tournament.WithdrawPlayer(players[1])
+	tournament.ReenterPlayer(players[1])
+	err = tournament.ConfirmReserve(players[1])
+	if err != ErrReserveOfferGone {
+		t.Fatal("The offer for a reentered player was confirmed")
+	}
+
+	result := tournament.ToMap(func(i int) string { return fmt.Sprint(i) })
+	reserves := result["reserves"].(map[string]any)
+	eq1 = slices.Equal(reserves["players"].([]string), []string{players[4].Id()})
+	if !eq1 || len(reserves["offers"].([]map[string]any)) != 0 {
+		t.Fatal("The reserve list was not marshalled")
+	}
+}
+
+func TestReserveAutoFill(t *testing.T) {
+	players, err := PlayerSlice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players[:4])
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	tournament.Reserves = ReserveList{Players: []Player{players[4]}, AutoFill: true}
+
+	started := tournament.MatchesOfPlayer(players[1])[0]
+	started.StartMatch()
+	tournament.WithdrawPlayer(players[1])
+	if len(tournament.Reserves.Offers()) != 0 {
+		t.Fatal("A reserve was offered after the player started playing")
+	}
+
+	other := players[2]
+	if started.ContainsPlayer(other) {
+		other = players[3]
+	}
+	withdrawMatches := tournament.WithdrawPlayer(other)
+
+	for _, m := range withdrawMatches {
+		if !m.ContainsPlayer(players[4]) {
+			t.Fatal("The reserve did not fill the place automatically")
+		}
+	}
+	if len(tournament.Reserves.Players) != 0 || len(tournament.Reserves.Offers()) != 0 {
+		t.Fatal("The filled reserve was not removed from the list")
+	}
+}
+
+func TestReserveAutoFillEntered(t *testing.T) {
+	players, err := PlayerSlice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players[:4])
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	tournament.Reserves = ReserveList{Players: []Player{players[0], players[4]}, AutoFill: true}
+
+	withdrawMatches := tournament.WithdrawPlayer(players[1])
+
+	for _, m := range withdrawMatches {
+		if !m.ContainsPlayer(players[4]) {
+			t.Fatal("The entered reserve was not skipped")
+		}
+	}
+	if len(tournament.Reserves.Offers()) != 0 {
+		t.Fatal("The offer of the entered reserve is still pending")
+	}
+}
@@ -0,0 +1,248 @@
+package core
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrNoOutcomes       = errors.New("no match outcomes were given")
+	ErrTooManyScenarios = errors.New("the number of scenarios exceeds the limit")
+)
+
+// The maximum number of scenarios that a scenario
+// analysis enumerates
+const MaxScenarios = 1 << 16
+
+type QualificationStatus int
+
+const (
+	// The qualification depends on the remaining matches
+	QualificationOpen QualificationStatus = iota
+	// The player qualifies no matter the remaining results
+	QualificationSecured QualificationStatus = iota
+	// The player can not qualify anymore
+	QualificationEliminated QualificationStatus = iota
+)
+
+// A Scenario is one combination of outcomes
+// of the remaining matches in a round robin
+type Scenario struct {
+	// For each remaining match the index of the
+	// outcome that it has in this scenario
+	Outcomes []int
+
+	// The players who qualify in this scenario
+	Qualified []Player
+
+	// The players who are tied across the qualification
+	// cut in this scenario. Their qualification depends on
+	// tie breakers.
+	Undecided []Player
+}
+
+// The summary of all scenarios for one player
+type PlayerScenarios struct {
+	Player Player
+	Status QualificationStatus
+
+	// The number of scenarios where the player qualifies
+	NumQualifying int
+	// The number of scenarios where the player is in
+	// a tie across the qualification cut
+	NumUndecided int
+
+	// For each remaining match of the player the indices of the
+	// outcomes that appear in at least one scenario where the player
+	// qualifies or is undecided
+	// (i.e. the results that the player needs)
+	RequiredOutcomes map[*Match][]int
+}
+
+// The result of an exhaustive analysis of the remaining
+// matches in a round robin
+type ScenarioAnalysis struct {
+	// The matches that have no result yet
+	RemainingMatches []*Match
+
+	// The outcomes that each remaining match can have
+	Outcomes []Score
+
+	Scenarios []*Scenario
+
+	// The scenario summaries by player ID
+	Players map[string]*PlayerScenarios
+}
+
+// Returns the scenarios where the given player qualifies
+func (a *ScenarioAnalysis) QualifyingScenarios(player Player) []*Scenario {
+	scenarios := make([]*Scenario, 0, len(a.Scenarios))
+	for _, s := range a.Scenarios {
+		if slices.Contains(s.Qualified, player) {
+			scenarios = append(scenarios, s)
+		}
+	}
+	return scenarios
+}
+
+// Enumerates all combinations of outcomes for the matches of the
+// round robin that have no result yet and determines for each
+// player whether they are already qualified, eliminated or
+// what results they need.
+//
+// The outcomes are the possible scores of a remaining match from
+// the perspective of its first slot. They should contain wins for
+// both opponents and can contain multiple scores per winner to
+// distinguish set and point outcomes (e.g. 2-0 and 2-1 in sets).
+//
+// The ranks in each scenario are determined by the same metrics
+// and tie breakers as the FinalRanking of the round robin.
+// When numQualifications is not positive, the RequiredUntiedRanks
+// of the FinalRanking are used (the qualifications of a group).
+func (t *RoundRobin) QualificationScenarios(
+	numQualifications int,
+	outcomes []Score,
+) (*ScenarioAnalysis, error) {
+	if len(outcomes) == 0 {
+		return nil, ErrNoOutcomes
+	}
+	if numQualifications <= 0 {
+		numQualifications = t.FinalRanking.RequiredUntiedRanks
+	}
+
+	scenarioMatches := make([]*Match, 0, len(t.Matches))
+	remaining := make([]*Match, 0, len(t.Matches))
+	remainingCopies := make([]*Match, 0, len(t.Matches))
+	for _, m := range t.Matches {
+		scenarioMatch := *m
+		scenarioMatches = append(scenarioMatches, &scenarioMatch)
+
+		if m.Slot1.Player == nil || m.Slot2.Player == nil {
+			continue
+		}
+		if m.HasBye() || m.IsWalkover() || m.IsTerminated() || m.Score != nil {
+			continue
+		}
+		remaining = append(remaining, m)
+		remainingCopies = append(remainingCopies, &scenarioMatch)
+	}
+
+	numScenarios := 1
+	for range remaining {
+		numScenarios *= len(outcomes)
+		if numScenarios > MaxScenarios {
+			return nil, ErrTooManyScenarios
+		}
+	}
+
+	analysis := &ScenarioAnalysis{
+		RemainingMatches: remaining,
+		Outcomes:         outcomes,
+		Scenarios:        make([]*Scenario, 0, numScenarios),
+		Players:          make(map[string]*PlayerScenarios),
+	}
+
+	scenarioRanking := t.FinalRanking.withMatches(scenarioMatches)
+	for _, p := range scenarioRanking.players {
+		analysis.Players[p.Id()] = &PlayerScenarios{
+			Player:           p,
+			RequiredOutcomes: make(map[*Match][]int),
+		}
+	}
+
+	outcomeIndices := make([]int, len(remaining))
+	for range numScenarios {
+		for i, m := range remainingCopies {
+			m.Score = outcomes[outcomeIndices[i]]
+		}
+		scenarioRanking.updateRanks()
+
+		scenario := createScenario(scenarioRanking, outcomeIndices, numQualifications)
+		analysis.Scenarios = append(analysis.Scenarios, scenario)
+		analysis.addScenario(scenario)
+
+		nextOutcomeCombination(outcomeIndices, len(outcomes))
+	}
+
+	analysis.updateStatuses()
+
+	return analysis, nil
+}
+
+func createScenario(ranking *MatchMetricRanking, outcomeIndices []int, numQualifications int) *Scenario {
+	scenario := &Scenario{
+		Outcomes:  slices.Clone(outcomeIndices),
+		Qualified: make([]Player, 0, numQualifications),
+		Undecided: make([]Player, 0),
+	}
+
+	place := 0
+	for _, tie := range ranking.TiedRanks() {
+		end := place + len(tie)
+		for _, slot := range tie {
+			switch {
+			case end <= numQualifications:
+				scenario.Qualified = append(scenario.Qualified, slot.Player)
+			case place < numQualifications:
+				scenario.Undecided = append(scenario.Undecided, slot.Player)
+			}
+		}
+		place = end
+	}
+
+	return scenario
+}
+
+func (a *ScenarioAnalysis) addScenario(scenario *Scenario) {
+	for _, p := range scenario.Qualified {
+		summary := a.Players[p.Id()]
+		summary.NumQualifying += 1
+		a.addRequiredOutcomes(summary, scenario)
+	}
+	for _, p := range scenario.Undecided {
+		summary := a.Players[p.Id()]
+		summary.NumUndecided += 1
+		a.addRequiredOutcomes(summary, scenario)
+	}
+}
+
+func (a *ScenarioAnalysis) addRequiredOutcomes(summary *PlayerScenarios, scenario *Scenario) {
+	for i, m := range a.RemainingMatches {
+		if !m.ContainsPlayer(summary.Player) {
+			continue
+		}
+		outcome := scenario.Outcomes[i]
+		required := summary.RequiredOutcomes[m]
+		if !slices.Contains(required, outcome) {
+			required = append(required, outcome)
+			slices.Sort(required)
+			summary.RequiredOutcomes[m] = required
+		}
+	}
+}
+
+func (a *ScenarioAnalysis) updateStatuses() {
+	numScenarios := len(a.Scenarios)
+	for _, summary := range a.Players {
+		switch {
+		case summary.NumQualifying == numScenarios:
+			summary.Status = QualificationSecured
+		case summary.NumQualifying == 0 && summary.NumUndecided == 0:
+			summary.Status = QualificationEliminated
+		default:
+			summary.Status = QualificationOpen
+		}
+	}
+}
+
+// Advances the mixed-radix counter of outcome indices to
+// the next combination
+func nextOutcomeCombination(outcomeIndices []int, numOutcomes int) {
+	for i := range outcomeIndices {
+		outcomeIndices[i] += 1
+		if outcomeIndices[i] < numOutcomes {
+			return
+		}
+		outcomeIndices[i] = 0
+	}
+}
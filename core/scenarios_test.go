@@ -0,0 +1,119 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+// Returns the match between the two players
+func findMatch(matches []*Match, p1, p2 Player) *Match {
+	i := slices.IndexFunc(matches, func(m *Match) bool {
+		return m.ContainsPlayer(p1) && m.ContainsPlayer(p2)
+	})
+	return matches[i]
+}
+
+// Completes the match between winner and loser
+func playMatch(matches []*Match, winner, loser Player) *Match {
+	m := findMatch(matches, winner, loser)
+	m.StartMatch()
+	if m.Slot1.Player == winner {
+		m.EndMatch(NewScore(21, 0))
+	} else {
+		m.EndMatch(NewScore(0, 21))
+	}
+	return m
+}
+
+func TestQualificationScenarios(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+	p3 := players[2]
+	p4 := players[3]
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	matches := tournament.matchList.Matches
+
+	outcomes := []Score{NewScore(21, 0), NewScore(0, 21)}
+
+	_, err = tournament.QualificationScenarios(2, nil)
+	if err != ErrNoOutcomes {
+		t.Fatal("The scenario analysis without outcomes did not error")
+	}
+
+	playMatch(matches, p1, p2)
+	playMatch(matches, p1, p3)
+	playMatch(matches, p1, p4)
+	playMatch(matches, p2, p3)
+	tournament.Update(nil)
+
+	analysis, err := tournament.QualificationScenarios(2, outcomes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eq1 := len(analysis.RemainingMatches) == 2
+	eq2 := len(analysis.Scenarios) == 4
+	if !eq1 || !eq2 {
+		t.Fatal("The scenarios of the remaining matches were not enumerated")
+	}
+
+	summary1 := analysis.Players[p1.Id()]
+	summary2 := analysis.Players[p2.Id()]
+	summary3 := analysis.Players[p3.Id()]
+	summary4 := analysis.Players[p4.Id()]
+
+	eq1 = summary1.Status == QualificationSecured
+	if !eq1 {
+		t.Fatal("The player with three wins is not qualified")
+	}
+
+	// When p4 beats p2 and p3 beats p4 the players p2, p3 and p4 are
+	// tied with one win and equal sets and points.
+	eq1 = summary2.Status == QualificationOpen && summary2.NumQualifying == 2 && summary2.NumUndecided == 1
+	eq2 = summary3.Status == QualificationOpen && summary3.NumQualifying == 0 && summary3.NumUndecided == 1
+	eq3 := summary4.Status == QualificationOpen && summary4.NumQualifying == 1 && summary4.NumUndecided == 1
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("The scenario summaries are not as expected")
+	}
+
+	playMatch(matches, p4, p3)
+	tournament.Update(nil)
+
+	analysis, _ = tournament.QualificationScenarios(2, outcomes)
+
+	summary2 = analysis.Players[p2.Id()]
+	summary3 = analysis.Players[p3.Id()]
+	summary4 = analysis.Players[p4.Id()]
+
+	eq1 = len(analysis.Scenarios) == 2
+	eq2 = summary3.Status == QualificationEliminated
+	eq3 = summary2.Status == QualificationOpen && summary4.Status == QualificationOpen
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("The scenario statuses did not update after another result")
+	}
+
+	lastMatch := analysis.RemainingMatches[0]
+	required := summary2.RequiredOutcomes[lastMatch]
+	eq1 = len(required) == 1
+	winner, _ := outcomes[required[0]].GetWinner()
+	eq2 = (winner == 0) == (lastMatch.Slot1.Player == p2)
+	if !eq1 || !eq2 {
+		t.Fatal("The required outcome is not a win in the last match")
+	}
+
+	eq1 = len(analysis.QualifyingScenarios(p2)) == 1
+	if !eq1 {
+		t.Fatal("The player does not qualify in exactly one scenario")
+	}
+
+	if tournament.FinalRanking.Metrics[p2].NumMatches != 2 || lastMatch.Score != nil {
+		t.Fatal("The scenario analysis changed the tournament")
+	}
+}
@@ -0,0 +1,257 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+)
+
+var (
+	ErrNoScoreGenerator = errors.New("the simulation has no score generator")
+)
+
+// The rating of players who do not have a rating
+// in the SimulationSettings
+const DefaultRating = 1500.0
+
+type SimulationSettings struct {
+	// The number of simulated tournament outcomes
+	Iterations int
+
+	// The seed of the random number generator
+	Seed int64
+
+	// Creates the score of a simulated match.
+	// The winner is 0 when the first opponent wins
+	// and 1 when the second opponent wins.
+	ScoreGenerator func(winner int, rng *rand.Rand) Score
+
+	// Optional ratings of the players by their ID.
+	// When no ratings are given each match is a coin flip.
+	// Otherwise the winners are drawn with Elo probabilities.
+	// Players without a rating get the DefaultRating.
+	Ratings map[string]float64
+}
+
+// The aggregated outcome of a tournament simulation
+type SimulationResult struct {
+	Iterations int
+
+	// The probability of each final place (index) by player ID
+	PlaceProbabilities map[string][]float64
+
+	// The probability of qualifying by player ID.
+	// Qualifying means finishing within the RequiredUntiedRanks
+	// of the simulated ranking (e.g. the qualifications of
+	// a GroupPhaseRanking).
+	// Is empty when the ranking has no required untied ranks.
+	QualificationProbabilities map[string]float64
+}
+
+// Simulates the remaining matches of the given tournament
+// and aggregates the outcomes.
+//
+// In each iteration the tournament is cloned (see [CloneTournament])
+// and every playable match that has no result is given a random score
+// from the settings' ScoreGenerator. This repeats until no playable
+// matches are left. Then the ranking returned by rankingOf is evaluated.
+//
+// Blocking ties that prevent the tournament from progressing
+// (e.g. ties in the qualification ranks of a group) are broken
+// by drawing lots. Ties that remain in the simulated ranking are
+// resolved the same way: the tied players share the probability
+// of the places that their tie spans.
+func SimulateTournament[T Tournament](
+	tournament T,
+	build func() (T, error),
+	rankingOf func(T) TieableRanking,
+	settings SimulationSettings,
+) (*SimulationResult, error) {
+	if settings.ScoreGenerator == nil {
+		return nil, ErrNoScoreGenerator
+	}
+
+	rng := rand.New(rand.NewSource(settings.Seed))
+
+	result := &SimulationResult{
+		Iterations:                 settings.Iterations,
+		PlaceProbabilities:         make(map[string][]float64),
+		QualificationProbabilities: make(map[string]float64),
+	}
+
+	for range settings.Iterations {
+		clone, err := CloneTournament(tournament, build)
+		if err != nil {
+			return nil, err
+		}
+
+		simulateMatches(clone, rng, &settings)
+
+		result.addOutcome(rankingOf(clone))
+	}
+
+	result.normalize()
+
+	return result, nil
+}
+
+// Fills all playable matches with random scores until no more
+// playable matches are left and no blocking ties remain
+func simulateMatches(tournament Tournament, rng *rand.Rand, settings *SimulationSettings) {
+	// Each step either completes at least one match or breaks the ties
+	// of a ranking. The limit only guards against a tie breaker
+	// that does not take effect.
+	maxSteps := 2*len(tournament.MatchList().Matches) + 1
+	for range maxSteps {
+		playable := playableMatches(tournament.MatchList().Matches)
+		if len(playable) == 0 && !drawBlockingTies(tournament, rng) {
+			return
+		}
+
+		for _, m := range playable {
+			winner := simulateWinner(m, rng, settings.Ratings)
+			m.Score = settings.ScoreGenerator(winner, rng)
+		}
+
+		updateUntilStable(tournament)
+	}
+}
+
+// Breaks the blocking ties of all rankings in the tournament
+// by adding randomly ordered tie breakers.
+// Returns true if any tie was broken.
+func drawBlockingTies(tournament Tournament, rng *rand.Rand) bool {
+	withGraph, ok := tournament.(interface{ rankingGraph() *RankingGraph })
+	if !ok {
+		return false
+	}
+
+	tiesBroken := false
+	for _, ranking := range withGraph.rankingGraph().Nodes() {
+		tieable, ok := ranking.(TieableRanking)
+		if !ok {
+			continue
+		}
+		withQualifications, ok := ranking.(interface{ requiredUntiedRanks() int })
+		if !ok {
+			continue
+		}
+
+		blockingTies := tieable.BlockingTies(withQualifications.requiredUntiedRanks())
+		for _, tie := range blockingTies {
+			hasEmptySlot := slices.ContainsFunc(tie, func(s *Slot) bool { return s.Player == nil })
+			if hasEmptySlot {
+				continue
+			}
+
+			lot := slices.Clone(tie)
+			shuffle(lot, rng)
+			tieable.AddTieBreaker(NewSlotRanking(lot))
+			tiesBroken = true
+		}
+	}
+
+	return tiesBroken
+}
+
+// Returns the matches that have two opponents but no result yet
+func playableMatches(matches []*Match) []*Match {
+	playable := make([]*Match, 0, len(matches))
+	for _, m := range matches {
+		if m.Slot1.Player == nil || m.Slot2.Player == nil {
+			continue
+		}
+		if m.HasBye() || m.IsWalkover() || m.IsTerminated() || m.Score != nil {
+			continue
+		}
+		playable = append(playable, m)
+	}
+	return playable
+}
+
+// Draws the winner of the match (0 or 1) according to the ratings
+func simulateWinner(match *Match, rng *rand.Rand, ratings map[string]float64) int {
+	if len(ratings) == 0 {
+		return rng.Intn(2)
+	}
+
+	rating1 := playerRating(match.Slot1.Player, ratings)
+	rating2 := playerRating(match.Slot2.Player, ratings)
+
+	winProbability1 := 1.0 / (1.0 + math.Pow(10, (rating2-rating1)/400.0))
+
+	if rng.Float64() < winProbability1 {
+		return 0
+	}
+	return 1
+}
+
+func playerRating(player Player, ratings map[string]float64) float64 {
+	rating, ok := ratings[player.Id()]
+	if !ok {
+		return DefaultRating
+	}
+	return rating
+}
+
+// Adds the outcome of one simulation iteration
+func (r *SimulationResult) addOutcome(ranking TieableRanking) {
+	numQualifications := 0
+	if withQualifications, ok := ranking.(interface{ requiredUntiedRanks() int }); ok {
+		numQualifications = withQualifications.requiredUntiedRanks()
+	}
+
+	numPlaces := len(ranking.Ranks())
+
+	place := 0
+	for _, tie := range ranking.TiedRanks() {
+		share := 1.0 / float64(len(tie))
+
+		qualifiedPlaces := max(0, min(place+len(tie), numQualifications)-place)
+		qualificationShare := float64(qualifiedPlaces) * share
+
+		for _, slot := range tie {
+			if slot.Player == nil || slot.IsBye() {
+				continue
+			}
+			id := slot.Player.Id()
+
+			probabilities, ok := r.PlaceProbabilities[id]
+			if !ok {
+				probabilities = make([]float64, numPlaces)
+			}
+			for len(probabilities) < numPlaces {
+				probabilities = append(probabilities, 0)
+			}
+			for i := range len(tie) {
+				probabilities[place+i] += share
+			}
+			r.PlaceProbabilities[id] = probabilities
+
+			if numQualifications > 0 {
+				r.QualificationProbabilities[id] += qualificationShare
+			}
+		}
+
+		place += len(tie)
+	}
+}
+
+// Turns the accumulated counts into probabilities
+func (r *SimulationResult) normalize() {
+	if r.Iterations == 0 {
+		return
+	}
+	n := float64(r.Iterations)
+
+	for _, probabilities := range r.PlaceProbabilities {
+		for i := range probabilities {
+			probabilities[i] /= n
+		}
+	}
+
+	for id := range r.QualificationProbabilities {
+		r.QualificationProbabilities[id] /= n
+	}
+}
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func testScoreGenerator(winner int, rng *rand.Rand) Score {
+	loserPoints := rng.Intn(21)
+	if winner == 0 {
+		return NewScore(21, loserPoints)
+	}
+	return NewScore(loserPoints, 21)
+}
+
+func TestCloneTournament(t *testing.T) {
+	players, err := PlayerSlice(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	build := func() (*SingleElimination, error) { return NewSingleElimination(entries) }
+	tournament, _ := build()
+
+	matches := tournament.matchList.Matches
+	for _, m := range matches[:4] {
+		m.StartMatch()
+		m.EndMatch(NewScore(0, 1))
+	}
+	tournament.WithdrawPlayer(players[7])
+	tournament.Update(nil)
+
+	clone, err := CloneTournament(tournament, build)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cloneMatches := clone.matchList.Matches
+	for i, m := range matches {
+		cloneMatch := cloneMatches[i]
+		eq1 := m.Slot1.Player == cloneMatch.Slot1.Player
+		eq2 := m.Slot2.Player == cloneMatch.Slot2.Player
+		eq3 := m.Score == cloneMatch.Score && m.EndTime == cloneMatch.EndTime
+		eq4 := m.IsWalkover() == cloneMatch.IsWalkover()
+		if !eq1 || !eq2 || !eq3 || !eq4 {
+			t.Fatal("The cloned match state is not equal to the source")
+		}
+	}
+
+	cloneMatches[4].Score = NewScore(1, 0)
+	clone.Update(nil)
+	if matches[4].Score != nil || matches[6].Slot1.Player != nil {
+		t.Fatal("Changing the clone changed the source tournament")
+	}
+
+	_, err = CloneTournament(tournament, func() (*SingleElimination, error) {
+		return NewSingleElimination(NewConstantRanking(players[:4]))
+	})
+	if err != ErrCloneMismatch {
+		t.Fatal("Cloning with a different structure did not error")
+	}
+}
+
+func TestSimulateRoundRobin(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	build := func() (*RoundRobin, error) { return NewRoundRobin(entries, 1, NewScore(21, 0)) }
+	tournament, _ := build()
+
+	rankingOf := func(t *RoundRobin) TieableRanking { return t.FinalRanking }
+
+	_, err = SimulateTournament(tournament, build, rankingOf, SimulationSettings{Iterations: 1})
+	if err != ErrNoScoreGenerator {
+		t.Fatal("The simulation without score generator did not error")
+	}
+
+	settings := SimulationSettings{
+		Iterations:     200,
+		Seed:           1,
+		ScoreGenerator: testScoreGenerator,
+		Ratings: map[string]float64{
+			players[0].Id(): 3000,
+		},
+	}
+
+	result, err := SimulateTournament(tournament, build, rankingOf, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range players {
+		probabilities := result.PlaceProbabilities[p.Id()]
+		sum := 0.0
+		for _, probability := range probabilities {
+			sum += probability
+		}
+		if len(probabilities) != 4 || math.Abs(sum-1) > 1e-9 {
+			t.Fatal("The place probabilities of a player do not sum up to 1")
+		}
+	}
+
+	if result.PlaceProbabilities[players[0].Id()][0] < 0.95 {
+		t.Fatal("The much higher rated player does not win almost every simulation")
+	}
+
+	for _, m := range tournament.matchList.Matches {
+		if m.Score != nil {
+			t.Fatal("The simulation changed the source tournament")
+		}
+	}
+}
+
+func TestSimulateQualification(t *testing.T) {
+	players, err := PlayerSlice(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	build := func() (*GroupKnockout, error) {
+		return NewGroupKnockout(entries, NewGroupKnockoutSingleElimination, 2, 4, NewScore(21, 0))
+	}
+	tournament, _ := build()
+
+	// The top seed of group 0 wins all of their matches
+	for _, m := range tournament.GroupPhase.Groups[0].MatchesOfPlayer(players[0]) {
+		m.StartMatch()
+		if m.Slot1.Player == players[0] {
+			m.EndMatch(NewScore(21, 0))
+		} else {
+			m.EndMatch(NewScore(0, 21))
+		}
+	}
+	tournament.Update(nil)
+
+	settings := SimulationSettings{
+		Iterations:     50,
+		ScoreGenerator: testScoreGenerator,
+	}
+
+	result, err := SimulateTournament(
+		tournament,
+		build,
+		func(t *GroupKnockout) TieableRanking { return t.GroupPhase.FinalRanking },
+		settings,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := 0.0
+	for _, probability := range result.QualificationProbabilities {
+		sum += probability
+	}
+	if math.Abs(sum-4) > 1e-9 {
+		t.Fatal("The qualification probabilities do not sum up to the number of qualifications")
+	}
+
+	if result.QualificationProbabilities[players[0].Id()] != 1 {
+		t.Fatal("The group winner does not qualify in every simulation")
+	}
+
+	result, err = SimulateTournament(
+		tournament,
+		build,
+		func(t *GroupKnockout) TieableRanking { return t.FinalRanking },
+		settings,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	winProbability := 0.0
+	for _, probabilities := range result.PlaceProbabilities {
+		winProbability += probabilities[0]
+	}
+	if math.Abs(winProbability-1) > 1e-9 {
+		t.Fatal("The knock out phase was not simulated to the end")
+	}
+}
@@ -31,7 +31,7 @@ func (l *matchList) MatchesOfPlayer(player Player) []*Match {
 // Returns true when all matches in the list are complete
 func (l *matchList) MatchesComplete() bool {
 	for _, m := range l.Matches {
-		if !m.HasBye() && !m.IsWalkover() && m.Score == nil {
+		if !m.HasBye() && !m.IsWalkover() && !m.IsTerminated() && m.Score == nil {
 			return false
 		}
 	}
@@ -67,6 +67,19 @@ type MatchLister interface {
 	MatchList() *matchList
 }
 
+// The Tournament interface is implemented by all
+// tournament modes via their BaseTournament.
+type Tournament interface {
+	MatchLister
+	RankingUpdater
+	WithdrawalPolicy
+	EditingPolicy
+
+	// Checks the invariants of the tournament
+	// (see [BaseTournament.Validate])
+	Validate() error
+}
+
 type BaseTournament[FinalRanking Ranking] struct {
 	// The entries ranking which contains
 	// the starting slots for all participants.
@@ -78,6 +91,10 @@ type BaseTournament[FinalRanking Ranking] struct {
 	WithdrawalPolicy
 	EditingPolicy
 
+	// The alternates who are offered as replacements
+	// of withdrawn players
+	Reserves ReserveList
+
 	id int
 }
 
@@ -105,6 +122,10 @@ func (t *BaseTournament[_]) MatchList() *matchList {
 	return t.matchList
 }
 
+func (t *BaseTournament[_]) rankingGraph() *RankingGraph {
+	return t.RankingGraph
+}
+
 func (t *BaseTournament[FinalRanking]) addTournamentData(
 	matchList *matchList,
 	rankingGraph *RankingGraph,
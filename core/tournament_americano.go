@@ -0,0 +1,423 @@
+package core
+
+import (
+	"slices"
+	"strings"
+)
+
+// An Americano is a social doubles format where the partners
+// rotate every round and each individual player earns the
+// points that their pairs score.
+//
+// The partners of each round are determined by the circle method
+// (see roundRobinCircleIndex) so that every player partners every
+// other player once before partnerships repeat. The pairs of a
+// round are then matched up such that opponents repeat as little
+// as possible. Players who can not be placed in a full match of
+// a round sit out that round.
+type Americano struct {
+	BaseTournament[*AmericanoRanking]
+
+	// The players who sit out in each round
+	SittingOut [][]Player
+
+	pairs map[string]*Pair
+}
+
+// Creates the rounds of the Americano. When numRounds is not positive
+// every player partners every other player exactly once.
+func (t *Americano) initTournament(
+	entries Ranking,
+	numRounds int,
+	walkoverScore Score,
+) error {
+	players := make([]Player, 0, len(entries.Ranks()))
+	for _, s := range entries.Ranks() {
+		if s.Player != nil {
+			players = append(players, s.Player)
+		}
+	}
+	if len(players) < 4 {
+		return ErrTooFewEntries
+	}
+
+	rankingGraph := NewRankingGraph(entries)
+
+	// A nil player pads the circle to an even length.
+	// The partner of the padding sits out.
+	circle := slices.Clone(players)
+	if len(circle)%2 != 0 {
+		circle = append(circle, nil)
+	}
+	numCircleRounds := len(circle) - 1
+	if numRounds <= 0 {
+		numRounds = numCircleRounds
+	}
+
+	scheduler := newAmericanoScheduler(players)
+	for roundI := range numRounds {
+		partners := circlePartners(circle, roundI%numCircleRounds)
+		sittingOut := scheduler.addRound(partners)
+		t.SittingOut = append(t.SittingOut, sittingOut)
+	}
+	scheduler.scheduleMatchups()
+
+	rounds := make([]*Round, 0, numRounds)
+	matches := make([]*Match, 0, numRounds*len(players)/4)
+	for _, roundMatchups := range scheduler.matchups {
+		round := &Round{Matches: make([]*Match, 0, len(roundMatchups))}
+		for _, matchup := range roundMatchups {
+			slot1 := NewPlayerSlot(t.pairOf(matchup[0]))
+			slot2 := NewPlayerSlot(t.pairOf(matchup[1]))
+			match := NewMatch(slot1, slot2)
+			match.AllowDraw = true
+			round.Matches = append(round.Matches, match)
+		}
+
+		rounds = append(rounds, round)
+		matches = append(matches, round.Matches...)
+	}
+
+	matchList := &matchList{Rounds: rounds, Matches: matches}
+
+	finalRanking := NewAmericanoRanking(entries, matches, walkoverScore, rankingGraph)
+
+	t.addTournamentData(matchList, rankingGraph, finalRanking)
+
+	return nil
+}
+
+// Configures how the match metrics of the ranking are
+// extracted from the matches (see [MetricSettings])
+func (t *Americano) UseMetricSettings(settings MetricSettings) {
+	t.FinalRanking.MetricSettings = settings
+	t.Update(nil)
+}
+
+// Returns the pair of the two players. The same pair
+// is returned when the players partner up again.
+func (t *Americano) pairOf(partners [2]Player) *Pair {
+	ids := []string{partners[0].Id(), partners[1].Id()}
+	slices.Sort(ids)
+	id := strings.Join(ids, "&")
+
+	pair, ok := t.pairs[id]
+	if !ok {
+		pair, _ = NewPair(id, partners[0], partners[1])
+		t.pairs[id] = pair
+	}
+	return pair
+}
+
+// Returns the partners of the given circle method round.
+// Partnerships with the nil padding are omitted.
+func circlePartners(circle []Player, roundI int) [][2]Player {
+	partners := make([][2]Player, 0, len(circle)/2)
+	for i := range len(circle) / 2 {
+		i1 := roundRobinCircleIndex(i, len(circle), roundI)
+		i2 := roundRobinCircleIndex(len(circle)-1-i, len(circle), roundI)
+		partners = append(partners, [2]Player{circle[i1], circle[i2]})
+	}
+	return partners
+}
+
+// The maximum number of pairs in a round for which all
+// possible matchups are compared (10 pairs have 945 matchups)
+const maxExhaustiveAmericanoPairs = 10
+
+// The americanoScheduler matches up the pairs of the rounds
+// while keeping track of who played against whom and who sat out
+type americanoScheduler struct {
+	sitOuts   map[Player]int
+	opponents map[Player]map[Player]int
+
+	// The pairs and the matchups of each round
+	pairs    [][][2]Player
+	matchups [][][2][2]Player
+}
+
+func newAmericanoScheduler(players []Player) *americanoScheduler {
+	scheduler := &americanoScheduler{
+		sitOuts:   make(map[Player]int),
+		opponents: make(map[Player]map[Player]int),
+	}
+	for _, p := range players {
+		scheduler.opponents[p] = make(map[Player]int)
+	}
+	return scheduler
+}
+
+// Adds a round with the given partners and returns the
+// players who sit out.
+//
+// When the number of pairs is odd, the pair that sat out the least
+// sits out.
+func (s *americanoScheduler) addRound(partners [][2]Player) []Player {
+	sittingOut := make([]Player, 0, 3)
+	pairs := make([][2]Player, 0, len(partners))
+	for _, p := range partners {
+		switch {
+		case p[0] == nil:
+			sittingOut = append(sittingOut, p[1])
+		case p[1] == nil:
+			sittingOut = append(sittingOut, p[0])
+		default:
+			pairs = append(pairs, p)
+		}
+	}
+
+	if len(pairs)%2 != 0 {
+		restingI := 0
+		for i, p := range pairs {
+			if s.sitOuts[p[0]]+s.sitOuts[p[1]] <= s.sitOuts[pairs[restingI][0]]+s.sitOuts[pairs[restingI][1]] {
+				restingI = i
+			}
+		}
+		sittingOut = append(sittingOut, pairs[restingI][0], pairs[restingI][1])
+		pairs = slices.Delete(pairs, restingI, restingI+1)
+	}
+
+	for _, p := range sittingOut {
+		s.sitOuts[p] += 1
+	}
+
+	s.pairs = append(s.pairs, pairs)
+	s.matchups = append(s.matchups, nil)
+
+	return sittingOut
+}
+
+// Matches up the pairs of all rounds one after another so that
+// the players face the opponents that they faced the least so far
+func (s *americanoScheduler) scheduleMatchups() {
+	for i := range s.pairs {
+		s.matchupRound(i)
+	}
+}
+
+// Chooses the matchups of the ith round with the least encounters.
+// Up to maxExhaustiveAmericanoPairs all possible matchups are
+// compared, above that the matchups are chosen greedily.
+func (s *americanoScheduler) matchupRound(i int) {
+	pairs := s.pairs[i]
+
+	var matchups [][2][2]Player
+	if len(pairs) <= maxExhaustiveAmericanoPairs {
+		matchups, _ = s.bestMatchups(pairs)
+	} else {
+		matchups = s.greedyMatchups(pairs)
+	}
+	for _, matchup := range matchups {
+		s.addEncounter(matchup[0], matchup[1])
+	}
+
+	s.matchups[i] = matchups
+}
+
+// Tries all possible matchups of the pairs and returns the
+// ones with the least encounters and their number of encounters
+func (s *americanoScheduler) bestMatchups(pairs [][2]Player) ([][2][2]Player, int) {
+	if len(pairs) == 0 {
+		return nil, 0
+	}
+
+	var best [][2][2]Player
+	bestEncounters := -1
+	for i := 1; i < len(pairs); i += 1 {
+		rest := slices.Concat(pairs[1:i], pairs[i+1:])
+		matchups, encounters := s.bestMatchups(rest)
+		encounters += s.numEncounters(pairs[0], pairs[i])
+		if bestEncounters == -1 || encounters < bestEncounters {
+			best = append([][2][2]Player{{pairs[0], pairs[i]}}, matchups...)
+			bestEncounters = encounters
+		}
+	}
+
+	return best, bestEncounters
+}
+
+// Returns the matchups of the pairs by repeatedly matching up
+// the two pairs whose players faced each other the least
+func (s *americanoScheduler) greedyMatchups(pairs [][2]Player) [][2][2]Player {
+	pairs = slices.Clone(pairs)
+	matchups := make([][2][2]Player, 0, len(pairs)/2)
+	for len(pairs) > 0 {
+		bestI, bestJ := 0, 1
+		for i := range pairs {
+			for j := i + 1; j < len(pairs); j += 1 {
+				if s.numEncounters(pairs[i], pairs[j]) < s.numEncounters(pairs[bestI], pairs[bestJ]) {
+					bestI, bestJ = i, j
+				}
+			}
+		}
+		matchups = append(matchups, [2][2]Player{pairs[bestI], pairs[bestJ]})
+		pairs = slices.Delete(pairs, bestJ, bestJ+1)
+		pairs = slices.Delete(pairs, bestI, bestI+1)
+	}
+	return matchups
+}
+
+// Returns how often the players of the two pairs faced each other
+func (s *americanoScheduler) numEncounters(pair1, pair2 [2]Player) int {
+	encounters := 0
+	for _, p1 := range pair1 {
+		for _, p2 := range pair2 {
+			encounters += s.opponents[p1][p2]
+		}
+	}
+	return encounters
+}
+
+func (s *americanoScheduler) addEncounter(pair1, pair2 [2]Player) {
+	for _, p1 := range pair1 {
+		for _, p2 := range pair2 {
+			s.opponents[p1][p2] += 1
+			s.opponents[p2][p1] += 1
+		}
+	}
+}
+
+// The AmericanoWithdrawalPolicy withdraws individual players
+// from the matches that have not started yet. The opponents of
+// the withdrawn player's pair receive the walkover score.
+type AmericanoWithdrawalPolicy struct {
+	entries    Ranking
+	matchList  *matchList
+	pairs      map[string]*Pair
+	sittingOut [][]Player
+}
+
+// Withdraws the given player from the tournament.
+// The specific matches that the player was withdrawn from
+// are returned.
+//
+// Unlike the other policies the individual player is recorded
+// in the WithdrawnPlayers of the matches and not their pair.
+func (w *AmericanoWithdrawalPolicy) WithdrawPlayer(player Player) []*Match {
+	withdrawMatches := w.ListWithdrawMatches(player)
+	for _, m := range withdrawMatches {
+		m.WithdrawnPlayers = append(m.WithdrawnPlayers, player)
+	}
+	return withdrawMatches
+}
+
+// Attempts to reenter the player into the tournament.
+// On success the specific matches that the player
+// was reentered into are returned.
+func (w *AmericanoWithdrawalPolicy) ReenterPlayer(player Player) []*Match {
+	reenterMatches := w.ListReenterMatches(player)
+	reenterIntoMatches(player, reenterMatches)
+	return reenterMatches
+}
+
+func (w *AmericanoWithdrawalPolicy) ListWithdrawMatches(player Player) []*Match {
+	withdrawMatches := make([]*Match, 0, 5)
+	for _, m := range w.matchList.MatchesOfPlayer(player) {
+		if m.StartTime.IsZero() && m.Score == nil && !m.IsWalkover() {
+			withdrawMatches = append(withdrawMatches, m)
+		}
+	}
+	return withdrawMatches
+}
+
+func (w *AmericanoWithdrawalPolicy) ListReenterMatches(player Player) []*Match {
+	reenterMatches := make([]*Match, 0, 5)
+	for _, m := range w.matchList.Matches {
+		isWithdrawn := slices.ContainsFunc(
+			m.WithdrawnPlayers,
+			func(p Player) bool { return p.Id() == player.Id() },
+		)
+		if isWithdrawn && m.StartTime.IsZero() {
+			reenterMatches = append(reenterMatches, m)
+		}
+	}
+	return reenterMatches
+}
+
+// Disqualifies the player from the tournament.
+// The player is withdrawn from the matches that have not started
+// and removed from the ranking. Like withdrawals, the disqualification
+// is recorded for the individual player and not their pairs.
+func (w *AmericanoWithdrawalPolicy) DisqualifyPlayer(player Player) []*Match {
+	for _, m := range w.ListWithdrawMatches(player) {
+		if !m.IsPlayerWithdrawn(player) {
+			m.WithdrawnPlayers = append(m.WithdrawnPlayers, player)
+		}
+	}
+
+	playerMatches := w.matchList.MatchesOfPlayer(player)
+	for _, m := range playerMatches {
+		if !m.IsPlayerDisqualified(player) {
+			m.DisqualifiedPlayers = append(m.DisqualifiedPlayers, player)
+		}
+	}
+
+	return playerMatches
+}
+
+// Replaces the player with the replacement in their entry slot
+// and as partner in all their pairs. The replacement also takes
+// over the rounds that the player sits out.
+// This is refused once a match of the player has started.
+func (w *AmericanoWithdrawalPolicy) ReplacePlayer(player, replacement Player) ([]*Match, error) {
+	entrySlot, err := replaceableEntrySlot(w.entries.Ranks(), player, replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	replaceMatches := w.matchList.MatchesOfPlayer(player)
+	if MatchesStarted(replaceMatches...) {
+		return nil, ErrReplacementStarted
+	}
+
+	reenterIntoMatches(player, replaceMatches)
+
+	for _, pair := range w.pairs {
+		if matchesPlayer(pair, player) {
+			pair.Substitute(player, replacement)
+		}
+	}
+
+	for _, sittingOut := range w.sittingOut {
+		for i, p := range sittingOut {
+			if p == player {
+				sittingOut[i] = replacement
+			}
+		}
+	}
+
+	entrySlot.Player = replacement
+
+	return replaceMatches, nil
+}
+
+// Creates an Americano tournament of the individual players in the
+// entries. The number of rounds defaults to one round per partner
+// of each player when it is not positive.
+func NewAmericano(entries Ranking, numRounds int, walkoverScore Score) (*Americano, error) {
+	americano := &Americano{
+		BaseTournament: newBaseTournament[*AmericanoRanking](entries),
+		pairs:          make(map[string]*Pair),
+	}
+	err := americano.initTournament(entries, numRounds, walkoverScore)
+	if err != nil {
+		return nil, err
+	}
+
+	matchList := americano.matchList
+
+	editingPolicy := &RoundRobinEditingPolicy{matches: matchList.Matches}
+
+	withdrawalPolicy := &AmericanoWithdrawalPolicy{
+		entries:    americano.Entries,
+		matchList:  matchList,
+		pairs:      americano.pairs,
+		sittingOut: americano.SittingOut,
+	}
+
+	americano.addPolicies(editingPolicy, withdrawalPolicy)
+
+	americano.Update(nil)
+
+	return americano, nil
+}
@@ -0,0 +1,215 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAmericano(t *testing.T) {
+	players, err := PlayerSlice(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewAmericano(NewConstantRanking(players[:3]), 0, NewScore(21, 0))
+	if err != ErrTooFewEntries {
+		t.Fatal("The Americano with 3 players did not error")
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewAmericano(entries, 0, NewScore(21, 0))
+
+	eq1 := len(tournament.Rounds) == 7 && len(tournament.Matches) == 14
+	if !eq1 {
+		t.Fatal("The 8-player Americano has an unexpected amount of rounds")
+	}
+
+	partnerships := make(map[string]int)
+	encounters := make(map[[2]Player]int)
+	for _, m := range tournament.Matches {
+		pair1 := m.Slot1.Player.(*Pair)
+		pair2 := m.Slot2.Player.(*Pair)
+		partnerships[pair1.Id()] += 1
+		partnerships[pair2.Id()] += 1
+		for _, p1 := range pair1.Members() {
+			for _, p2 := range pair2.Members() {
+				encounters[[2]Player{p1, p2}] += 1
+				encounters[[2]Player{p2, p1}] += 1
+			}
+		}
+	}
+
+	eq1 = len(partnerships) == 28
+	for _, n := range partnerships {
+		eq1 = eq1 && n == 1
+	}
+	if !eq1 {
+		t.Fatal("Not every player partnered every other player exactly once")
+	}
+
+	// The partners of the circle method force some players
+	// to face each other 4 times in an Americano of 8 players
+	for _, n := range encounters {
+		if n > 4 {
+			t.Fatal("Two players faced each other too often")
+		}
+	}
+
+	for _, sittingOut := range tournament.SittingOut {
+		if len(sittingOut) != 0 {
+			t.Fatal("A player sat out in an Americano of 8 players")
+		}
+	}
+
+	match := tournament.Rounds[0].Matches[0]
+	match.StartMatch()
+	match.EndMatch(NewScore(21, 15))
+	drawn := tournament.Rounds[0].Matches[1]
+	drawn.StartMatch()
+	drawn.EndMatch(NewScore(12, 12))
+	tournament.Update(nil)
+
+	winners := match.Slot1.Player.(*Pair).Members()
+	metrics := tournament.FinalRanking.Metrics[winners[0]]
+	eq1 = metrics.PointWins == 21 && metrics.PointLosses == 15 && metrics.Wins == 1
+	if !eq1 {
+		t.Fatal("The points of the pair were not credited to the individual players")
+	}
+
+	ranks := tournament.FinalRanking.TiedRanks()
+	eq1 = len(ranks[0]) == 2
+	eq2 := ranks[0][0].Player == winners[0] || ranks[0][0].Player == winners[1]
+	eq3 := len(ranks[1]) == 2 && len(ranks[2]) == 4
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("The players were not ranked by their points won")
+	}
+
+	if len(tournament.EditableMatches()) != 2 {
+		t.Fatal("The won and drawn matches are not editable")
+	}
+}
+
+func TestAmericanoSitOuts(t *testing.T) {
+	players, err := PlayerSlice(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players[:5])
+	tournament, _ := NewAmericano(entries, 0, NewScore(21, 0))
+
+	sitOuts := make(map[Player]int)
+	for i, r := range tournament.Rounds {
+		if len(r.Matches) != 1 || len(tournament.SittingOut[i]) != 1 {
+			t.Fatal("Not exactly one player sat out in an Americano of 5 players")
+		}
+		sitOuts[tournament.SittingOut[i][0]] += 1
+	}
+	if len(tournament.Rounds) != 5 || len(sitOuts) != 5 {
+		t.Fatal("Not every player sat out exactly once")
+	}
+
+	entries = NewConstantRanking(players)
+	tournament, _ = NewAmericano(entries, 5, NewScore(21, 0))
+
+	sitOuts = make(map[Player]int)
+	for i, r := range tournament.Rounds {
+		if len(r.Matches) != 1 || len(tournament.SittingOut[i]) != 2 {
+			t.Fatal("Not exactly one pair sat out in an Americano of 6 players")
+		}
+		for _, p := range tournament.SittingOut[i] {
+			sitOuts[p] += 1
+		}
+	}
+	for _, p := range players {
+		if sitOuts[p] < 1 || sitOuts[p] > 2 {
+			t.Fatal("The sit outs are not evenly distributed")
+		}
+	}
+}
+
+func TestAmericanoWithdrawal(t *testing.T) {
+	players, err := PlayerSlice(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewAmericano(entries, 0, NewScore(21, 0))
+
+	withdrawn := players[0]
+	playerMatches := tournament.MatchesOfPlayer(withdrawn)
+	playerMatches[0].StartMatch()
+
+	withdrawnMatches := tournament.WithdrawPlayer(withdrawn)
+	tournament.Update(nil)
+
+	eq1 := len(playerMatches) == 7 && len(withdrawnMatches) == 6
+	eq2 := !playerMatches[0].IsWalkover() && playerMatches[1].IsWalkover()
+	if !eq1 || !eq2 {
+		t.Fatal("The player was not withdrawn from exactly the unstarted matches")
+	}
+
+	match := playerMatches[1]
+	winner, _ := match.GetWinner()
+	winnerMember := winner.Player.(*Pair).Members()[0]
+	numWalkovers := 0
+	for _, m := range withdrawnMatches {
+		if m.ContainsPlayer(winnerMember) {
+			numWalkovers += 1
+		}
+	}
+	if tournament.FinalRanking.Metrics[winnerMember].PointWins != 21*numWalkovers {
+		t.Fatal("The opponents did not receive the walkover score")
+	}
+
+	reentered := tournament.ReenterPlayer(withdrawn)
+	tournament.Update(nil)
+	if len(reentered) != 6 || match.IsWalkover() {
+		t.Fatal("The player was not reentered")
+	}
+
+	result := tournament.ToMap(func(i int) string { return string(rune('a' + i)) })
+	if result["type"] != "Americano" || len(result["sittingOut"].([][]string)) != 7 {
+		t.Fatal("The Americano was not marshalled")
+	}
+}
+
+func TestAmericanoReplacement(t *testing.T) {
+	players, err := PlayerSlice(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reserve := players[5]
+
+	entries := NewConstantRanking(players[:5])
+	tournament, _ := NewAmericano(entries, 0, NewScore(21, 0))
+
+	replaceMatches, err := tournament.ReplacePlayer(players[0], reserve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tournament.Update(nil)
+
+	for _, m := range replaceMatches {
+		if !m.ContainsPlayer(reserve) || m.ContainsPlayer(players[0]) {
+			t.Fatal("The replacement was not substituted into the pairs")
+		}
+	}
+	for _, sittingOut := range tournament.SittingOut {
+		if slices.Contains(sittingOut, players[0]) {
+			t.Fatal("The replaced player still sits out a round")
+		}
+	}
+
+	_, ok := tournament.FinalRanking.Metrics[reserve]
+	if !ok {
+		t.Fatal("The ranking did not pick up the replacement")
+	}
+
+	tournament.MatchesOfPlayer(players[1])[0].StartMatch()
+	_, err = tournament.ReplacePlayer(players[1], players[0])
+	if err != ErrReplacementStarted {
+		t.Fatal("A player was replaced after their match started")
+	}
+}
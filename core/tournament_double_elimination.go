@@ -189,6 +189,7 @@ func createDoubleElimination(entries Ranking, rankingGraph *RankingGraph) (*Doub
 	}
 
 	withdrawalPolicy := &EliminationWithdrawalPolicy{
+		entries:          doubleElimination.Entries,
 		matchList:        matchList,
 		eliminationGraph: eliminationGraph,
 	}
@@ -86,6 +86,30 @@ func (t *GroupKnockout) OverrideQualifications(override []Player) {
 	t.qualificationRanking.qualificationOverride = override
 }
 
+// Allows the matches of the group phase to end in a draw.
+// The knock out matches still require a winner.
+// See [GroupPhase.AllowDraws].
+func (t *GroupKnockout) AllowDraws(pointsTable PointsTable) {
+	t.GroupPhase.AllowDraws(pointsTable)
+	t.Update(nil)
+}
+
+// Ranks the players of the group phase by the points that
+// the given table awards for their match outcomes.
+// See [GroupPhase.UsePointsTable].
+func (t *GroupKnockout) UsePointsTable(pointsTable PointsTable) {
+	t.GroupPhase.UsePointsTable(pointsTable)
+	t.Update(nil)
+}
+
+// Configures how the match metrics of the group phase are
+// extracted from the matches.
+// See [GroupPhase.UseMetricSettings].
+func (t *GroupKnockout) UseMetricSettings(settings MetricSettings) {
+	t.GroupPhase.UseMetricSettings(settings)
+	t.Update(nil)
+}
+
 type GroupKnockoutEditingPolicy struct {
 	editableMatches []*Match
 	groupPhase      *GroupPhase
@@ -148,6 +172,21 @@ func (w *GroupKnockoutWithdrawalPolicy) ListReenterMatches(player Player) []*Mat
 	}
 }
 
+// Replaces the player with the replacement in the group phase.
+// Once the knock out started all group matches have started
+// and the replacement is refused.
+func (w *GroupKnockoutWithdrawalPolicy) ReplacePlayer(player, replacement Player) ([]*Match, error) {
+	return w.groupPhase.ReplacePlayer(player, replacement)
+}
+
+// Disqualifies the player from the group phase and
+// from the knock out if they already qualified for it
+func (w *GroupKnockoutWithdrawalPolicy) DisqualifyPlayer(player Player) []*Match {
+	groupMatches := w.groupPhase.DisqualifyPlayer(player)
+	knockOutMatches := w.knockOut.DisqualifyPlayer(player)
+	return slices.Concat(groupMatches, knockOutMatches)
+}
+
 func NewGroupKnockout(
 	entries Ranking,
 	knockoutBuilder KnockoutBuilder,
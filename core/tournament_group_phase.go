@@ -59,6 +59,41 @@ func (t *GroupPhase) initTournament(
 	t.addTournamentData(matchList, rankingGraph, finalRanking)
 }
 
+// Allows the group matches to end in a draw and ranks
+// the players in the groups and across the groups by the
+// points that the given table awards for their wins,
+// draws and losses.
+func (t *GroupPhase) AllowDraws(pointsTable PointsTable) {
+	allowDraws(t.Matches)
+	t.UsePointsTable(pointsTable)
+}
+
+// Ranks the players in the groups and across the groups
+// by the points that the given table awards for their
+// match outcomes instead of their wins.
+func (t *GroupPhase) UsePointsTable(pointsTable PointsTable) {
+	usePointsTable(pointsTable, t.metricRankings()...)
+	t.Update(nil)
+}
+
+// Configures how the match metrics of the groups and the
+// cross group ranking are extracted from the matches
+// (see [MetricSettings])
+func (t *GroupPhase) UseMetricSettings(settings MetricSettings) {
+	useMetricSettings(settings, t.metricRankings()...)
+	t.Update(nil)
+}
+
+// Returns the rankings of the groups and the cross group ranking
+func (t *GroupPhase) metricRankings() []*MatchMetricRanking {
+	rankings := make([]*MatchMetricRanking, 0, len(t.Groups)+1)
+	for _, g := range t.Groups {
+		rankings = append(rankings, g.FinalRanking)
+	}
+	rankings = append(rankings, t.FinalRanking.crossGroupRanking.(*MatchMetricRanking))
+	return rankings
+}
+
 func (t *GroupPhase) createMatchList() *matchList {
 	lastGroup := t.Groups[len(t.Groups)-1]
 	maxNumRounds := len(lastGroup.matchList.Rounds)
@@ -180,6 +215,7 @@ func newGroupPhase(
 	}
 
 	withdrawalPolicy := &RoundRobinWithdrawalPolicy{
+		entries:   groupPhase.Entries,
 		matchList: matchList,
 	}
 
@@ -263,6 +263,7 @@ func TestGroupPhaseWithdrawal(t *testing.T) {
 	trueMetrics := &MatchMetrics{
 		NumMatches:      1,
 		Wins:            1,
+		WalkoverWins:    1,
 		NumSets:         1,
 		SetWins:         1,
 		PointWins:       walkoverScore.Points1()[0],
@@ -0,0 +1,358 @@
+package core
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrNotOnLadder       = errors.New("the player is not on the ladder")
+	ErrChallengeSelf     = errors.New("a player can not challenge themselves")
+	ErrChallengeBelow    = errors.New("the defender is not above the challenger")
+	ErrChallengeTooFar   = errors.New("the defender is too far above the challenger")
+	ErrOpenChallenge     = errors.New("the player already has an open challenge")
+	ErrWithdrawnOpponent = errors.New("the player has withdrawn from the ladder")
+	ErrChallengeDistance = errors.New("the maximum challenge distance has to be at least 1")
+)
+
+// The rules of a ladder
+type LadderSettings struct {
+	// The maximum number of places that a challenger
+	// can challenge above their position. Has to be at least 1.
+	MaxChallengeDistance int
+
+	// The time after which an unplayed challenge expires.
+	// Challenges do not expire when this is 0.
+	ChallengeDuration time.Duration
+
+	// When true the defender forfeits an expired challenge and the
+	// challenger wins by walkover. Otherwise the challenge is
+	// cancelled without a result.
+	ForfeitOnExpiry bool
+}
+
+// A Challenge is a match between a challenger and
+// a defender who is above them on the ladder
+type Challenge struct {
+	// The match where Slot1 is the challenger
+	// and Slot2 is the defender
+	Match *Match
+
+	Challenger, Defender Player
+
+	Created time.Time
+	// Is zero when the challenge does not expire
+	Expires time.Time
+
+	// True when the challenge expired before it was played
+	Expired bool
+}
+
+// Returns true when the challenge was neither played
+// nor expired
+func (c *Challenge) IsOpen() bool {
+	winner, _ := c.Match.GetWinner()
+	return winner == nil && !c.Expired
+}
+
+// A Ladder is a season-long ranking where players challenge
+// someone up to MaxChallengeDistance places above them and swap
+// positions with them when they win.
+//
+// The matches of the ladder are created by the challenges. Each
+// challenge is its own round.
+type Ladder struct {
+	BaseTournament[*LadderRanking]
+
+	Settings LadderSettings
+}
+
+// Returns the challenges in the order that they were issued
+func (t *Ladder) Challenges() []*Challenge {
+	return t.FinalRanking.challenges
+}
+
+// Creates a challenge of the defender by the challenger.
+//
+// The defender has to be at most MaxChallengeDistance places above
+// the challenger and neither player can have an open challenge or
+// be withdrawn.
+func (t *Ladder) IssueChallenge(challenger, defender Player, now time.Time) (*Challenge, error) {
+	ranking := t.FinalRanking
+
+	challengerPos := ranking.Position(challenger)
+	defenderPos := ranking.Position(defender)
+	if challengerPos == -1 || defenderPos == -1 {
+		return nil, ErrNotOnLadder
+	}
+
+	switch distance := challengerPos - defenderPos; {
+	case distance == 0:
+		return nil, ErrChallengeSelf
+	case distance < 0:
+		return nil, ErrChallengeBelow
+	case distance > t.Settings.MaxChallengeDistance:
+		return nil, ErrChallengeTooFar
+	}
+
+	if slices.Contains(ranking.withdrawn, challenger) || slices.Contains(ranking.withdrawn, defender) {
+		return nil, ErrWithdrawnOpponent
+	}
+
+	for _, c := range ranking.challenges {
+		if !c.IsOpen() {
+			continue
+		}
+		if c.Match.ContainsPlayer(challenger) || c.Match.ContainsPlayer(defender) {
+			return nil, ErrOpenChallenge
+		}
+	}
+
+	match := NewMatch(NewPlayerSlot(challenger), NewPlayerSlot(defender))
+
+	challenge := &Challenge{
+		Match:      match,
+		Challenger: challenger,
+		Defender:   defender,
+		Created:    now,
+	}
+	if t.Settings.ChallengeDuration > 0 {
+		challenge.Expires = now.Add(t.Settings.ChallengeDuration)
+	}
+
+	t.matchList.Matches = append(t.matchList.Matches, match)
+	t.matchList.Rounds = append(t.matchList.Rounds, &Round{Matches: []*Match{match}})
+	ranking.challenges = append(ranking.challenges, challenge)
+
+	t.Update(nil)
+
+	return challenge, nil
+}
+
+// Expires the open challenges that were not started
+// before their expiry time and returns them.
+//
+// With ForfeitOnExpiry the defenders are withdrawn from the
+// expired challenges so that the challengers win by walkover.
+func (t *Ladder) ExpireChallenges(now time.Time) []*Challenge {
+	expired := make([]*Challenge, 0, 2)
+	for _, c := range t.FinalRanking.challenges {
+		if c.Expires.IsZero() || !c.IsOpen() || !c.Match.StartTime.IsZero() {
+			continue
+		}
+		if now.Before(c.Expires) {
+			continue
+		}
+
+		if t.Settings.ForfeitOnExpiry {
+			withdrawFromMatches(c.Defender, []*Match{c.Match})
+		}
+		c.Expired = true
+		expired = append(expired, c)
+	}
+
+	if len(expired) > 0 {
+		t.Update(nil)
+	}
+
+	return expired
+}
+
+type LadderEditingPolicy struct {
+	editableMatches []*Match
+	matchList       *matchList
+}
+
+// Returns the comprehensive list of matches that are editable
+func (e *LadderEditingPolicy) EditableMatches() []*Match {
+	return e.editableMatches
+}
+
+// Updates the return value of EditableMatches.
+//
+// All played challenges are editable. Changing a result
+// changes the positions of all later challenges because
+// the ladder is replayed.
+func (e *LadderEditingPolicy) UpdateEditableMatches() {
+	editableMatches := make([]*Match, 0, len(e.matchList.Matches))
+	for _, m := range e.matchList.Matches {
+		winner, _ := m.GetWinner()
+		if winner != nil && !m.IsWalkover() {
+			editableMatches = append(editableMatches, m)
+		}
+	}
+
+	e.editableMatches = editableMatches
+}
+
+// The LadderWithdrawalPolicy withdraws players from their open
+// challenges and moves them to the bottom of the ladder.
+type LadderWithdrawalPolicy struct {
+	ranking *LadderRanking
+}
+
+// Withdraws the given player from the tournament.
+// The specific matches that the player was withdrawn from
+// are returned.
+func (w *LadderWithdrawalPolicy) WithdrawPlayer(player Player) []*Match {
+	if w.ranking.Position(player) == -1 || slices.Contains(w.ranking.withdrawn, player) {
+		return nil
+	}
+
+	withdrawMatches := w.ListWithdrawMatches(player)
+	withdrawFromMatches(player, withdrawMatches)
+	w.ranking.withdrawn = append(w.ranking.withdrawn, player)
+	w.ranking.withdrawals = append(w.ranking.withdrawals, ladderWithdrawal{
+		player:          player,
+		afterChallenges: len(w.ranking.challenges),
+	})
+	return withdrawMatches
+}
+
+// Attempts to reenter the player into the tournament.
+// On success the specific matches that the player
+// was reentered into are returned.
+// The player keeps their position at the bottom of the ladder.
+func (w *LadderWithdrawalPolicy) ReenterPlayer(player Player) []*Match {
+	reenterMatches := w.ListReenterMatches(player)
+	reenterIntoMatches(player, reenterMatches)
+	w.ranking.withdrawn = slices.DeleteFunc(w.ranking.withdrawn, func(p Player) bool { return p == player })
+	return reenterMatches
+}
+
+func (w *LadderWithdrawalPolicy) ListWithdrawMatches(player Player) []*Match {
+	withdrawMatches := make([]*Match, 0, 1)
+	for _, c := range w.ranking.challenges {
+		if c.IsOpen() && c.Match.ContainsPlayer(player) {
+			withdrawMatches = append(withdrawMatches, c.Match)
+		}
+	}
+	return withdrawMatches
+}
+
+func (w *LadderWithdrawalPolicy) ListReenterMatches(player Player) []*Match {
+	reenterMatches := make([]*Match, 0, 1)
+	for _, c := range w.ranking.challenges {
+		if !c.Expired && c.Match.StartTime.IsZero() && c.Match.IsPlayerWithdrawn(player) {
+			reenterMatches = append(reenterMatches, c.Match)
+		}
+	}
+	return reenterMatches
+}
+
+// Disqualifies the player from the ladder.
+// The player is withdrawn from their open challenges and
+// removed from the ladder.
+func (w *LadderWithdrawalPolicy) DisqualifyPlayer(player Player) []*Match {
+	if w.ranking.Position(player) == -1 {
+		return nil
+	}
+
+	playerMatches := make([]*Match, 0, len(w.ranking.challenges))
+	for _, c := range w.ranking.challenges {
+		if c.Match.ContainsPlayer(player) {
+			playerMatches = append(playerMatches, c.Match)
+		}
+	}
+
+	withdrawMatches := slices.DeleteFunc(w.ListWithdrawMatches(player), func(m *Match) bool {
+		return m.IsPlayerWithdrawn(player)
+	})
+	withdrawFromMatches(player, withdrawMatches)
+
+	for _, m := range playerMatches {
+		m.DisqualifiedPlayers = append(m.DisqualifiedPlayers, player)
+	}
+	w.ranking.disqualified = append(w.ranking.disqualified, player)
+
+	return playerMatches
+}
+
+// Replaces the player with the replacement at their place on
+// the ladder. The replacement takes over the challenges of the
+// player and a withdrawal from the ladder is lifted. The replacement
+// takes the place that the player had before they withdrew.
+// This is refused once a challenge of the player has started.
+func (w *LadderWithdrawalPolicy) ReplacePlayer(player, replacement Player) ([]*Match, error) {
+	entrySlot, err := replaceableEntrySlot(w.ranking.entrySlots, player, replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	challenges := make([]*Challenge, 0, 2)
+	replaceMatches := make([]*Match, 0, 2)
+	for _, c := range w.ranking.challenges {
+		if c.Match.ContainsPlayer(player) {
+			challenges = append(challenges, c)
+			replaceMatches = append(replaceMatches, c.Match)
+		}
+	}
+	if MatchesStarted(replaceMatches...) {
+		return nil, ErrReplacementStarted
+	}
+
+	reenterIntoMatches(player, w.ListReenterMatches(player))
+	w.ranking.withdrawn = slices.DeleteFunc(w.ranking.withdrawn, func(p Player) bool { return p == player })
+	w.ranking.withdrawals = slices.DeleteFunc(w.ranking.withdrawals, func(l ladderWithdrawal) bool {
+		return l.player == player
+	})
+
+	for _, c := range challenges {
+		for slot := range c.Match.Slots {
+			if slot.Player == player {
+				slot.Player = replacement
+			}
+		}
+		// The forfeits of expired challenges remain
+		for i, p := range c.Match.WithdrawnPlayers {
+			if p == player {
+				c.Match.WithdrawnPlayers[i] = replacement
+			}
+		}
+		if c.Challenger == player {
+			c.Challenger = replacement
+		} else {
+			c.Defender = replacement
+		}
+	}
+
+	entrySlot.Player = replacement
+
+	return replaceMatches, nil
+}
+
+// Creates a ladder with the order of the entries as
+// the initial positions
+func NewLadder(entries Ranking, settings LadderSettings) (*Ladder, error) {
+	if len(entries.Ranks()) < 2 {
+		return nil, ErrTooFewEntries
+	}
+	if settings.MaxChallengeDistance < 1 {
+		return nil, ErrChallengeDistance
+	}
+
+	ladder := &Ladder{
+		BaseTournament: newBaseTournament[*LadderRanking](entries),
+		Settings:       settings,
+	}
+
+	rankingGraph := NewRankingGraph(entries)
+	finalRanking := NewLadderRanking(entries, rankingGraph)
+	matchList := &matchList{
+		Matches: make([]*Match, 0),
+		Rounds:  make([]*Round, 0),
+	}
+
+	ladder.addTournamentData(matchList, rankingGraph, finalRanking)
+
+	editingPolicy := &LadderEditingPolicy{matchList: matchList}
+
+	withdrawalPolicy := &LadderWithdrawalPolicy{ranking: finalRanking}
+
+	ladder.addPolicies(editingPolicy, withdrawalPolicy)
+
+	ladder.Update(nil)
+
+	return ladder, nil
+}
@@ -0,0 +1,232 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLadder(t *testing.T) {
+	players, err := PlayerSlice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	settings := LadderSettings{MaxChallengeDistance: 2}
+	tournament, _ := NewLadder(entries, settings)
+	ranking := tournament.FinalRanking
+	now := time.Now()
+
+	_, err = tournament.IssueChallenge(players[4], players[1], now)
+	if err != ErrChallengeTooFar {
+		t.Fatal("The challenge of a too high player did not error")
+	}
+	_, err = tournament.IssueChallenge(players[1], players[4], now)
+	if err != ErrChallengeBelow {
+		t.Fatal("The challenge of a lower player did not error")
+	}
+	_, err = tournament.IssueChallenge(players[1], players[1], now)
+	if err != ErrChallengeSelf {
+		t.Fatal("The challenge of oneself did not error")
+	}
+
+	challenge, err := tournament.IssueChallenge(players[4], players[2], now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tournament.IssueChallenge(players[3], players[2], now)
+	if err != ErrOpenChallenge {
+		t.Fatal("The challenge of a player with an open challenge did not error")
+	}
+
+	challenge.Match.StartMatch()
+	challenge.Match.EndMatch(NewScore(21, 10))
+	tournament.Update(nil)
+
+	eq1 := ranking.Position(players[4]) == 2 && ranking.Position(players[2]) == 4
+	eq2 := len(ranking.History) == 2 && ranking.History[0].From == 4 && ranking.History[0].To == 2
+	if !eq1 || !eq2 {
+		t.Fatal("The winning challenger did not swap positions with the defender")
+	}
+
+	challenge, _ = tournament.IssueChallenge(players[4], players[0], now)
+	challenge.Match.StartMatch()
+	challenge.Match.EndMatch(NewScore(10, 21))
+	tournament.Update(nil)
+
+	if ranking.Position(players[4]) != 2 || len(ranking.History) != 2 {
+		t.Fatal("The losing challenger changed positions")
+	}
+
+	first := tournament.Challenges()[0]
+	first.Match.Score = NewScore(10, 21)
+	tournament.Update(nil)
+
+	if ranking.Position(players[4]) != 4 || len(tournament.EditableMatches()) != 2 {
+		t.Fatal("The edited result was not replayed")
+	}
+
+	result := tournament.ToMap(func(i int) string { return string(rune('a' + i)) })
+	eq1 = result["type"] == "Ladder" && len(result["challenges"].([]map[string]any)) == 2
+	eq2 = len(result["rounds"].([][]string)) == 2
+	if !eq1 || !eq2 {
+		t.Fatal("The ladder was not marshalled")
+	}
+}
+
+func TestLadderExpiry(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	settings := LadderSettings{
+		MaxChallengeDistance: 1,
+		ChallengeDuration:    24 * time.Hour,
+	}
+	tournament, _ := NewLadder(entries, settings)
+	ranking := tournament.FinalRanking
+	now := time.Now()
+
+	challenge, _ := tournament.IssueChallenge(players[1], players[0], now)
+
+	expired := tournament.ExpireChallenges(now.Add(time.Hour))
+	if len(expired) != 0 || !challenge.IsOpen() {
+		t.Fatal("The challenge expired too early")
+	}
+
+	expired = tournament.ExpireChallenges(now.Add(25 * time.Hour))
+	eq1 := len(expired) == 1 && challenge.Expired && !challenge.IsOpen()
+	eq2 := ranking.Position(players[1]) == 1
+	if !eq1 || !eq2 {
+		t.Fatal("The expired challenge was not cancelled")
+	}
+
+	tournament.Settings.ForfeitOnExpiry = true
+	challenge, _ = tournament.IssueChallenge(players[3], players[2], now)
+	tournament.ExpireChallenges(now.Add(25 * time.Hour))
+
+	eq1 = challenge.Expired && challenge.Match.IsPlayerWithdrawn(players[2])
+	eq2 = ranking.Position(players[3]) == 2
+	if !eq1 || !eq2 {
+		t.Fatal("The defender did not forfeit the expired challenge")
+	}
+}
+
+func TestLadderWithdrawal(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewLadder(entries, LadderSettings{MaxChallengeDistance: 1})
+	ranking := tournament.FinalRanking
+	now := time.Now()
+
+	challenge, _ := tournament.IssueChallenge(players[2], players[1], now)
+
+	withdrawn := tournament.WithdrawPlayer(players[1])
+	tournament.Update(nil)
+
+	eq1 := len(withdrawn) == 1 && challenge.Match.IsWalkover()
+	eq2 := ranking.Position(players[1]) == 3 && ranking.Position(players[2]) == 1
+	if !eq1 || !eq2 {
+		t.Fatal("The withdrawn player was not moved to the bottom")
+	}
+
+	_, err = tournament.IssueChallenge(players[3], players[1], now)
+	if err != ErrChallengeBelow {
+		t.Fatal("The withdrawn player is still above the challenger")
+	}
+	_, err = tournament.IssueChallenge(players[1], players[3], now)
+	if err != ErrWithdrawnOpponent {
+		t.Fatal("The withdrawn player could issue a challenge")
+	}
+
+	reentered := tournament.ReenterPlayer(players[1])
+	tournament.Update(nil)
+
+	if len(reentered) != 1 || ranking.Position(players[1]) != 3 || !challenge.IsOpen() {
+		t.Fatal("The player was not reentered at the bottom")
+	}
+}
+
+func TestLadderChallengeAfterWithdrawal(t *testing.T) {
+	players, err := PlayerSlice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	_, err = NewLadder(entries, LadderSettings{})
+	if err != ErrChallengeDistance {
+		t.Fatal("A ladder without a challenge distance was created")
+	}
+
+	tournament, _ := NewLadder(entries, LadderSettings{MaxChallengeDistance: 2})
+	ranking := tournament.FinalRanking
+	now := time.Now()
+
+	// The ladder is p0 p2 p3 p4 p1 after the withdrawal
+	tournament.WithdrawPlayer(players[1])
+	tournament.Update(nil)
+
+	challenge, err := tournament.IssueChallenge(players[4], players[2], now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	challenge.Match.StartMatch()
+	challenge.Match.EndMatch(NewScore(21, 10))
+	tournament.Update(nil)
+
+	change := ranking.History[0]
+	eq1 := change.From == 3 && change.To == 1
+	eq2 := ranking.Position(players[4]) == 1 && ranking.Position(players[2]) == 3
+	if !eq1 || !eq2 {
+		t.Fatal("The challenge did not move the players by the shown distance")
+	}
+
+	tournament.ReenterPlayer(players[1])
+	tournament.Update(nil)
+
+	if ranking.Position(players[1]) != 4 || ranking.Position(players[4]) != 1 {
+		t.Fatal("The reentered player did not keep their position at the bottom")
+	}
+}
+
+func TestLadderReplacement(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reserve := players[3]
+
+	entries := NewConstantRanking(players[:3])
+	tournament, _ := NewLadder(entries, LadderSettings{MaxChallengeDistance: 1})
+	now := time.Now()
+
+	challenge, _ := tournament.IssueChallenge(players[2], players[1], now)
+	tournament.WithdrawPlayer(players[2])
+	tournament.Update(nil)
+
+	_, err = tournament.ReplacePlayer(players[2], reserve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tournament.Update(nil)
+
+	eq1 := challenge.Challenger == reserve && challenge.Match.Slot1.Player == reserve
+	eq2 := challenge.IsOpen() && tournament.FinalRanking.Position(reserve) == 2
+	if !eq1 || !eq2 {
+		t.Fatal("The replacement did not take over the place and challenge")
+	}
+
+	challenge.Match.StartMatch()
+	_, err = tournament.ReplacePlayer(players[1], players[2])
+	if err != ErrReplacementStarted {
+		t.Fatal("A player was replaced after their challenge started")
+	}
+}
@@ -0,0 +1,401 @@
+package core
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrQualifierCount      = errors.New("the number of qualifiers has to be a power of two and smaller than the qualifying draw")
+	ErrNotLuckyLoser       = errors.New("the player is not an available lucky loser")
+	ErrNotWithdrawnEntry   = errors.New("the player is not a withdrawn entry of the main draw")
+	ErrMainDrawMatchPlayed = errors.New("the main draw match of the withdrawn player has already started")
+)
+
+// A QualifyingMainDraw is a two-stage elimination tournament.
+//
+// The qualifying draw is a single elimination that stops when
+// the number of qualifiers is left. The qualifiers fill the lowest
+// seeds of the main draw behind the direct entries.
+//
+// The losers of the final qualifying round are the lucky losers.
+// They can replace players who withdraw from the main draw
+// before their first match.
+type QualifyingMainDraw struct {
+	BaseTournament[*EliminationRanking]
+	Qualifying *SingleElimination
+	MainDraw   *SingleElimination
+
+	mainDrawEntries *MainDrawEntryRanking
+}
+
+func (t *QualifyingMainDraw) initTournament(
+	directEntries, qualifyingEntries Ranking,
+	numQualifiers int,
+) error {
+	qualifyingSize := nextPowerOfTwo(len(qualifyingEntries.Ranks()))
+	if numQualifiers < 1 || nextPowerOfTwo(numQualifiers) != numQualifiers {
+		return ErrQualifierCount
+	}
+	if 2*numQualifiers > qualifyingSize {
+		return ErrQualifierCount
+	}
+
+	rankingGraph := NewRankingGraph(t.Entries)
+	rankingGraph.AddVertex(directEntries)
+	rankingGraph.AddEdge(t.Entries, directEntries)
+	rankingGraph.AddVertex(qualifyingEntries)
+	rankingGraph.AddEdge(t.Entries, qualifyingEntries)
+
+	qualifying, err := createEliminationDraw(qualifyingEntries, true, numQualifiers, rankingGraph)
+	if err != nil {
+		return err
+	}
+	t.Qualifying = qualifying
+
+	t.mainDrawEntries = NewMainDrawEntryRanking(directEntries, qualifying, rankingGraph)
+
+	mainDraw, err := createSingleElimination(t.mainDrawEntries, true, rankingGraph)
+	if err != nil {
+		return err
+	}
+	t.MainDraw = mainDraw
+
+	matchList := t.createMatchList()
+
+	finalRanking := NewEliminationRanking(
+		matchList,
+		t.Entries,
+		[]Ranking{t.MainDraw.FinalRanking},
+		rankingGraph,
+	)
+
+	t.addTournamentData(matchList, rankingGraph, finalRanking)
+
+	return nil
+}
+
+func (t *QualifyingMainDraw) createMatchList() *matchList {
+	ml1 := t.Qualifying.matchList
+	ml2 := t.MainDraw.matchList
+
+	matches := slices.Concat(ml1.Matches, ml2.Matches)
+	rounds := slices.Concat(ml1.Rounds, ml2.Rounds)
+
+	return &matchList{Matches: matches, Rounds: rounds}
+}
+
+// Returns the lucky losers that are available to replace a
+// withdrawn main draw player. These are the losers of the final
+// qualifying round who did not withdraw and are not already
+// in the main draw.
+func (t *QualifyingMainDraw) LuckyLosers() []Player {
+	luckyLosers := make([]Player, 0, len(t.mainDrawEntries.luckyLosers))
+	for _, slot := range t.mainDrawEntries.luckyLosers {
+		if slot.Player == nil || slot.IsBye() {
+			continue
+		}
+		if t.mainDrawEntries.placementOf(slot.Player) != nil {
+			continue
+		}
+		luckyLosers = append(luckyLosers, slot.Player)
+	}
+	return luckyLosers
+}
+
+// Replaces the withdrawn player in the main draw with the lucky loser.
+//
+// The withdrawn player has to be withdrawn from their first main draw
+// match and that match must not have started yet. The lucky loser
+// has to be one of [QualifyingMainDraw.LuckyLosers].
+func (t *QualifyingMainDraw) ReplaceWithLuckyLoser(withdrawn, luckyLoser Player) error {
+	placement := t.mainDrawEntries.placementOf(withdrawn)
+	if placement == nil {
+		return ErrNotWithdrawnEntry
+	}
+
+	match := t.firstMainDrawMatch(placement.slot)
+	if match == nil || !match.IsPlayerWithdrawn(withdrawn) {
+		return ErrNotWithdrawnEntry
+	}
+	if !match.StartTime.IsZero() {
+		return ErrMainDrawMatchPlayed
+	}
+
+	luckyLoserSlot := t.mainDrawEntries.luckyLoserSlot(luckyLoser)
+	if luckyLoserSlot == nil || !slices.Contains(t.LuckyLosers(), luckyLoser) {
+		return ErrNotLuckyLoser
+	}
+
+	placement.replacement = luckyLoserSlot
+	reenterIntoMatches(withdrawn, []*Match{match})
+
+	t.Update(nil)
+
+	return nil
+}
+
+func (t *QualifyingMainDraw) firstMainDrawMatch(slot *Slot) *Match {
+	for _, m := range t.MainDraw.Rounds[0].Matches {
+		if m.Slot1 == slot || m.Slot2 == slot {
+			return m
+		}
+	}
+	return nil
+}
+
+// The MainDrawEntryRanking holds the entry slots of the main draw.
+//
+// The first slots are the direct entries followed by the qualifiers.
+// Each slot is resolved through a [LuckyLoserPlacement] which can
+// swap the original entry for a lucky loser.
+type MainDrawEntryRanking struct {
+	BaseRanking
+
+	placements []*LuckyLoserPlacement
+
+	// The loser slots of the final qualifying round matches
+	luckyLosers []*Slot
+}
+
+// Updates the return value of the GetRanks() method.
+// Should be called whenever a result that influences the
+// ranking becomes known.
+func (r *MainDrawEntryRanking) updateRanks() {
+	// The ranks are constant. The placements resolve the
+	// current entries.
+}
+
+// Returns the placement that currently resolves to the player
+func (r *MainDrawEntryRanking) placementOf(player Player) *LuckyLoserPlacement {
+	if player == nil {
+		return nil
+	}
+	for _, p := range r.placements {
+		slot := p.Slot()
+		if slot != nil && slot.Player == player {
+			return p
+		}
+	}
+	return nil
+}
+
+func (r *MainDrawEntryRanking) luckyLoserSlot(player Player) *Slot {
+	for _, s := range r.luckyLosers {
+		if s.Player != nil && s.Player == player {
+			return s
+		}
+	}
+	return nil
+}
+
+// Creates the entry ranking of the main draw from the direct
+// entries and the qualifiers of the qualifying draw.
+//
+// The ranking depends on the final ranking of the qualifying
+// draw such that it is updated after all qualifying results
+// are known.
+func NewMainDrawEntryRanking(
+	directEntries Ranking,
+	qualifying *SingleElimination,
+	rankingGraph *RankingGraph,
+) *MainDrawEntryRanking {
+	finalRound := qualifying.Rounds[len(qualifying.Rounds)-1]
+	numEntries := len(directEntries.Ranks()) + len(finalRound.Matches)
+
+	ranking := &MainDrawEntryRanking{
+		BaseRanking: NewBaseRanking(),
+		placements:  make([]*LuckyLoserPlacement, 0, numEntries),
+		luckyLosers: make([]*Slot, 0, len(finalRound.Matches)),
+	}
+
+	sources := slices.Clone(directEntries.Ranks())
+	for _, m := range finalRound.Matches {
+		winnerRanking := qualifying.WinnerRankings[m]
+		qualifier := NewPlacementSlot(NewPlacement(winnerRanking, 0))
+		luckyLoser := NewPlacementSlot(NewPlacement(winnerRanking, 1))
+		sources = append(sources, qualifier)
+		ranking.luckyLosers = append(ranking.luckyLosers, luckyLoser)
+	}
+
+	slots := make([]*Slot, 0, numEntries)
+	for _, source := range sources {
+		placement := &LuckyLoserPlacement{ranking: ranking, source: source}
+		slot := NewPlacementSlot(placement)
+		placement.slot = slot
+		ranking.placements = append(ranking.placements, placement)
+		slots = append(slots, slot)
+	}
+	ranking.ranks = slots
+
+	rankingGraph.AddVertex(ranking)
+	rankingGraph.AddEdge(qualifying.FinalRanking, ranking)
+
+	return ranking
+}
+
+// A LuckyLoserPlacement resolves to its source slot (a direct
+// entry or a qualifier) until a lucky loser replaces the source.
+type LuckyLoserPlacement struct {
+	ranking     *MainDrawEntryRanking
+	slot        *Slot
+	source      *Slot
+	replacement *Slot
+}
+
+// Returns the current Slot at the Placement
+func (p *LuckyLoserPlacement) Slot() *Slot {
+	if p.replacement != nil {
+		return p.replacement
+	}
+	return p.source
+}
+
+func (p *LuckyLoserPlacement) Ranking() Ranking {
+	return p.ranking
+}
+
+// Returns true when a lucky loser replaced the original entry
+func (p *LuckyLoserPlacement) IsReplaced() bool {
+	return p.replacement != nil
+}
+
+type QualifyingMainDrawEditingPolicy struct {
+	editableMatches []*Match
+	qualifying      *SingleElimination
+	mainDraw        *SingleElimination
+}
+
+func (e *QualifyingMainDrawEditingPolicy) UpdateEditableMatches() {
+	mainDrawStarted := e.mainDraw.matchList.MatchesStarted()
+	if mainDrawStarted {
+		e.mainDraw.UpdateEditableMatches()
+		e.editableMatches = e.mainDraw.EditableMatches()
+	} else {
+		e.qualifying.UpdateEditableMatches()
+		e.editableMatches = e.qualifying.EditableMatches()
+	}
+}
+
+func (e *QualifyingMainDrawEditingPolicy) EditableMatches() []*Match {
+	return e.editableMatches
+}
+
+// The QualifyingMainDrawWithdrawalPolicy withdraws players from
+// the qualifying draw while they are still in it and from the
+// main draw otherwise.
+type QualifyingMainDrawWithdrawalPolicy struct {
+	entries    Ranking
+	matchList  *matchList
+	qualifying *SingleElimination
+	mainDraw   *SingleElimination
+}
+
+// Withdraws the given player from the tournament.
+// The specific matches that the player was withdrawn from
+// are returned.
+func (w *QualifyingMainDrawWithdrawalPolicy) WithdrawPlayer(player Player) []*Match {
+	withdrawMatches := w.ListWithdrawMatches(player)
+	withdrawFromMatches(player, withdrawMatches)
+	return withdrawMatches
+}
+
+// Attempts to reenter the player into the tournament.
+// On success the specific matches that the player
+// was reentered into are returned.
+func (w *QualifyingMainDrawWithdrawalPolicy) ReenterPlayer(player Player) []*Match {
+	reenterMatches := w.ListReenterMatches(player)
+	reenterIntoMatches(player, reenterMatches)
+	return reenterMatches
+}
+
+func (w *QualifyingMainDrawWithdrawalPolicy) ListWithdrawMatches(player Player) []*Match {
+	qualifyingMatches := w.qualifying.ListWithdrawMatches(player)
+	if len(qualifyingMatches) > 0 {
+		return qualifyingMatches
+	}
+	return w.mainDraw.ListWithdrawMatches(player)
+}
+
+func (w *QualifyingMainDrawWithdrawalPolicy) ListReenterMatches(player Player) []*Match {
+	return slices.Concat(
+		w.qualifying.ListReenterMatches(player),
+		w.mainDraw.ListReenterMatches(player),
+	)
+}
+
+// Replaces the player with the replacement in their direct entry
+// or qualifying entry. The replacement takes over the matches of
+// the player in both draws which is refused once one of them started.
+func (w *QualifyingMainDrawWithdrawalPolicy) ReplacePlayer(player, replacement Player) ([]*Match, error) {
+	qualifyingStarted := dependantMatchesStarted(player, w.qualifying.matchList, w.qualifying.EliminationGraph)
+	mainDrawStarted := dependantMatchesStarted(player, w.mainDraw.matchList, w.mainDraw.EliminationGraph)
+	if qualifyingStarted || mainDrawStarted || w.qualifierStarted(player) {
+		return nil, ErrReplacementStarted
+	}
+	return replaceEntry(w.entries, w.matchList, player, replacement)
+}
+
+// Returns true when the player's opponent in the final qualifying
+// round already started playing in the main draw
+func (w *QualifyingMainDrawWithdrawalPolicy) qualifierStarted(player Player) bool {
+	lastRound := w.qualifying.Rounds[len(w.qualifying.Rounds)-1]
+	for _, m := range lastRound.Matches {
+		if !m.ContainsPlayer(player) {
+			continue
+		}
+		winner, _ := m.GetWinner()
+		if winner != nil && winner.Player != nil {
+			return MatchesStarted(w.mainDraw.MatchesOfPlayer(winner.Player)...)
+		}
+	}
+	return false
+}
+
+// Disqualifies the player from the qualifying draw and
+// from the main draw if they already entered it
+func (w *QualifyingMainDrawWithdrawalPolicy) DisqualifyPlayer(player Player) []*Match {
+	qualifyingMatches := w.qualifying.DisqualifyPlayer(player)
+	mainDrawMatches := w.mainDraw.DisqualifyPlayer(player)
+	return slices.Concat(qualifyingMatches, mainDrawMatches)
+}
+
+// Creates a qualifying draw of the qualifyingEntries that
+// feeds numQualifiers players into the main draw with the
+// directEntries.
+//
+// The numQualifiers has to be a power of two and at most half
+// of the (padded) qualifying draw size.
+func NewQualifyingMainDraw(
+	directEntries, qualifyingEntries Ranking,
+	numQualifiers int,
+) (*QualifyingMainDraw, error) {
+	entrySlots := slices.Concat(directEntries.Ranks(), qualifyingEntries.Ranks())
+	entries := NewSlotRanking(entrySlots)
+
+	tournament := &QualifyingMainDraw{
+		BaseTournament: newBaseTournament[*EliminationRanking](entries),
+	}
+	err := tournament.initTournament(directEntries, qualifyingEntries, numQualifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	editingPolicy := &QualifyingMainDrawEditingPolicy{
+		qualifying: tournament.Qualifying,
+		mainDraw:   tournament.MainDraw,
+	}
+
+	withdrawalPolicy := &QualifyingMainDrawWithdrawalPolicy{
+		entries:    tournament.Entries,
+		matchList:  tournament.matchList,
+		qualifying: tournament.Qualifying,
+		mainDraw:   tournament.MainDraw,
+	}
+
+	tournament.addPolicies(editingPolicy, withdrawalPolicy)
+
+	tournament.Update(nil)
+
+	return tournament, nil
+}
@@ -0,0 +1,142 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+// Plays the matches with the player who comes first
+// in the players slice winning
+func playBySeed(matches []*Match, players []Player) {
+	for _, m := range matches {
+		if m.HasBye() || m.IsWalkover() {
+			continue
+		}
+		p1 := slices.Index(players, m.Slot1.Player)
+		p2 := slices.Index(players, m.Slot2.Player)
+		m.StartMatch()
+		if p1 < p2 {
+			m.EndMatch(NewScore(1, 0))
+		} else {
+			m.EndMatch(NewScore(0, 1))
+		}
+	}
+}
+
+func TestQualifyingMainDrawStructure(t *testing.T) {
+	players, err := PlayerSlice(14)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	directEntries := NewConstantRanking(players[:6])
+	qualifyingEntries := NewConstantRanking(players[6:])
+
+	_, err = NewQualifyingMainDraw(directEntries, qualifyingEntries, 3)
+	if err != ErrQualifierCount {
+		t.Fatal("a number of qualifiers that is not a power of two did not error")
+	}
+	_, err = NewQualifyingMainDraw(directEntries, qualifyingEntries, 8)
+	if err != ErrQualifierCount {
+		t.Fatal("a qualifying draw without matches did not error")
+	}
+
+	tournament, err := NewQualifyingMainDraw(directEntries, qualifyingEntries, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eq1 := len(tournament.Qualifying.Rounds) == 2
+	eq2 := len(tournament.MainDraw.Rounds) == 3
+	eq3 := len(tournament.Matches) == 6+7
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("the draws have an unexpected amount of rounds")
+	}
+
+	playBySeed(tournament.Qualifying.Rounds[0].Matches, players)
+	tournament.Update(nil)
+
+	mainEntries := tournament.MainDraw.Entries.Ranks()
+	if mainEntries[6].Player != nil || mainEntries[7].Player != nil {
+		t.Fatal("the qualifiers were placed before the qualifying was complete")
+	}
+
+	playBySeed(tournament.Qualifying.Rounds[1].Matches, players)
+	tournament.Update(nil)
+
+	eq1 = mainEntries[6].Player == players[6] || mainEntries[6].Player == players[7]
+	eq2 = mainEntries[7].Player == players[6] || mainEntries[7].Player == players[7]
+	if !eq1 || !eq2 {
+		t.Fatal("the qualifiers did not enter the main draw")
+	}
+
+	luckyLosers := tournament.LuckyLosers()
+	eq1 = len(luckyLosers) == 2
+	eq2 = slices.Contains(luckyLosers, players[8]) && slices.Contains(luckyLosers, players[9])
+	if !eq1 || !eq2 {
+		t.Fatal("the losers of the final qualifying round are not the lucky losers")
+	}
+
+	ranks := tournament.FinalRanking.TiedRanks()
+	if len(ranks[len(ranks)-1]) != 4 {
+		t.Fatal("the first round qualifying losers are not ranked last")
+	}
+}
+
+func TestLuckyLoserReplacement(t *testing.T) {
+	players, err := PlayerSlice(14)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	directEntries := NewConstantRanking(players[:6])
+	qualifyingEntries := NewConstantRanking(players[6:])
+	tournament, _ := NewQualifyingMainDraw(directEntries, qualifyingEntries, 2)
+
+	for _, r := range tournament.Qualifying.Rounds {
+		playBySeed(r.Matches, players)
+		tournament.Update(nil)
+	}
+
+	luckyLoser := tournament.LuckyLosers()[0]
+
+	err = tournament.ReplaceWithLuckyLoser(players[0], luckyLoser)
+	if err != ErrNotWithdrawnEntry {
+		t.Fatal("a player who did not withdraw was replaced")
+	}
+
+	withdrawMatches := tournament.WithdrawPlayer(players[0])
+	tournament.Update(nil)
+	if len(withdrawMatches) != 1 || !withdrawMatches[0].IsWalkover() {
+		t.Fatal("the player was not withdrawn from the main draw")
+	}
+
+	err = tournament.ReplaceWithLuckyLoser(players[0], players[10])
+	if err != ErrNotLuckyLoser {
+		t.Fatal("a first round qualifying loser was accepted as lucky loser")
+	}
+
+	err = tournament.ReplaceWithLuckyLoser(players[0], luckyLoser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	match := withdrawMatches[0]
+	eq1 := match.ContainsPlayer(luckyLoser) && !match.ContainsPlayer(players[0])
+	if !eq1 || match.IsWalkover() {
+		t.Fatal("the lucky loser did not replace the withdrawn player")
+	}
+	if slices.Contains(tournament.LuckyLosers(), luckyLoser) {
+		t.Fatal("the placed lucky loser is still available")
+	}
+
+	started := tournament.MainDraw.matchList.MatchesOfPlayer(players[1])[0]
+	started.StartMatch()
+	tournament.WithdrawPlayer(players[1])
+	tournament.Update(nil)
+
+	err = tournament.ReplaceWithLuckyLoser(players[1], tournament.LuckyLosers()[0])
+	if err != ErrMainDrawMatchPlayed {
+		t.Fatal("a player was replaced after their match started")
+	}
+}
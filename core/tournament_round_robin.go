@@ -111,6 +111,46 @@ func roundRobinCircleIndex(index, length, round int) int {
 	return index
 }
 
+// Allows the matches of the round robin to end in a draw
+// and ranks the players by the points that the given table
+// awards for their wins, draws and losses.
+func (t *RoundRobin) AllowDraws(pointsTable PointsTable) {
+	allowDraws(t.Matches)
+	t.UsePointsTable(pointsTable)
+}
+
+// Ranks the players by the points that the given table
+// awards for their match outcomes instead of their wins.
+func (t *RoundRobin) UsePointsTable(pointsTable PointsTable) {
+	usePointsTable(pointsTable, t.FinalRanking)
+	t.Update(nil)
+}
+
+// Configures how the match metrics of the ranking are
+// extracted from the matches (see [MetricSettings])
+func (t *RoundRobin) UseMetricSettings(settings MetricSettings) {
+	useMetricSettings(settings, t.FinalRanking)
+	t.Update(nil)
+}
+
+func allowDraws(matches []*Match) {
+	for _, m := range matches {
+		m.AllowDraw = true
+	}
+}
+
+func usePointsTable(pointsTable PointsTable, rankings ...*MatchMetricRanking) {
+	for _, r := range rankings {
+		r.PointsTable = &pointsTable
+	}
+}
+
+func useMetricSettings(settings MetricSettings, rankings ...*MatchMetricRanking) {
+	for _, r := range rankings {
+		r.MetricSettings = settings
+	}
+}
+
 type RoundRobinEditingPolicy struct {
 	editableMatches []*Match
 	matches         []*Match
@@ -128,7 +168,7 @@ func (e *RoundRobinEditingPolicy) UpdateEditableMatches() {
 		winner, _ := m.GetWinner()
 		wo := m.IsWalkover()
 		bye := m.HasBye()
-		if winner != nil && !wo && !bye {
+		if (winner != nil || m.IsDraw()) && !wo && !bye {
 			editableMatches = append(editableMatches, m)
 		}
 	}
@@ -137,6 +177,7 @@ func (e *RoundRobinEditingPolicy) UpdateEditableMatches() {
 }
 
 type RoundRobinWithdrawalPolicy struct {
+	entries   Ranking
 	matchList *matchList
 }
 
@@ -171,7 +212,7 @@ func (w *RoundRobinWithdrawalPolicy) ListReenterMatches(player Player) []*Match
 	for _, m := range w.matchList.Matches {
 		isWithdrawn := slices.ContainsFunc(
 			m.WithdrawnPlayers,
-			func(p Player) bool { return p.Id() == player.Id() },
+			func(p Player) bool { return matchesPlayer(p, player) },
 		)
 		if isWithdrawn {
 			withdrawnMatches = append(withdrawnMatches, m)
@@ -180,6 +221,20 @@ func (w *RoundRobinWithdrawalPolicy) ListReenterMatches(player Player) []*Match
 	return withdrawnMatches
 }
 
+// Replaces the player with the replacement in their entry slot.
+// The replacement takes over all matches of the player which is
+// refused once one of them has started.
+func (w *RoundRobinWithdrawalPolicy) ReplacePlayer(player, replacement Player) ([]*Match, error) {
+	return replaceEntry(w.entries, w.matchList, player, replacement)
+}
+
+// Disqualifies the player from the tournament.
+// All matches of the player count as walkovers for the
+// opponents and the player is removed from the ranking.
+func (w *RoundRobinWithdrawalPolicy) DisqualifyPlayer(player Player) []*Match {
+	return disqualifyFromMatches(player, w.ListWithdrawMatches(player), w.matchList)
+}
+
 func createRoundRobin(entries Ranking, passes int, walkoverScore Score, rankingGraph *RankingGraph) (*RoundRobin, error) {
 	roundRobin := &RoundRobin{
 		BaseTournament: newBaseTournament[*MatchMetricRanking](entries),
@@ -198,7 +253,10 @@ func createRoundRobin(entries Ranking, passes int, walkoverScore Score, rankingG
 
 	editingPolicy := &RoundRobinEditingPolicy{matches: matchList.Matches}
 
-	withdrawalPolicy := &RoundRobinWithdrawalPolicy{matchList: matchList}
+	withdrawalPolicy := &RoundRobinWithdrawalPolicy{
+		entries:   roundRobin.Entries,
+		matchList: matchList,
+	}
 
 	roundRobin.addPolicies(editingPolicy, withdrawalPolicy)
 
@@ -211,6 +269,22 @@ func NewRoundRobin(entries Ranking, passes int, walkoverScore Score) (*RoundRobi
 	return createRoundRobin(entries, passes, walkoverScore, nil)
 }
 
+// Creates a round robin that is ranked by the points that
+// the given table awards for the match outcomes (a league).
+func NewPointsTableRoundRobin(
+	entries Ranking,
+	passes int,
+	walkoverScore Score,
+	pointsTable PointsTable,
+) (*RoundRobin, error) {
+	tournament, err := createRoundRobin(entries, passes, walkoverScore, nil)
+	if err != nil {
+		return nil, err
+	}
+	tournament.UsePointsTable(pointsTable)
+	return tournament, nil
+}
+
 func newGroupRoundRobin(entries Ranking, requiredUntiedRanks int, walkoverScore Score, rankingGraph *RankingGraph) (*RoundRobin, error) {
 	tournament, err := createRoundRobin(entries, 1, walkoverScore, rankingGraph)
 	if err != nil {
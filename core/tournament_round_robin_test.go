@@ -2,6 +2,7 @@ package core
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -371,3 +372,326 @@ func TestRoundRobinTies(t *testing.T) {
 		t.Fatal("The players with equal match metrics were not ranked by the result of their direct encounter")
 	}
 }
+
+func TestRoundRobinDraws(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+	p3 := players[2]
+	p4 := players[3]
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	tournament.AllowDraws(PointsTable{Win: 3, Draw: 1, Loss: 0})
+
+	matches := tournament.matchList.Matches
+	finalRanking := tournament.FinalRanking
+
+	// p1 draws twice, p2 wins once and loses once
+	for _, m := range []*Match{findMatch(matches, p1, p2), findMatch(matches, p1, p3)} {
+		m.StartMatch()
+		m.EndMatch(NewScore(5, 5))
+	}
+	playMatch(matches, p2, p4)
+	playMatch(matches, p3, p2)
+	tournament.Update(nil)
+
+	metrics1 := finalRanking.Metrics[p1]
+	eq1 := metrics1.NumMatches == 2 && metrics1.Draws == 2 && metrics1.Wins == 0
+	eq2 := metrics1.PointWins == 10 && metrics1.PointLosses == 10
+	if !eq1 || !eq2 {
+		t.Fatal("The drawn matches were not counted in the metrics")
+	}
+
+	drawnMatch := findMatch(matches, p1, p2)
+	editable := false
+	for _, m := range tournament.EditableMatches() {
+		editable = editable || m == drawnMatch
+	}
+	if !editable {
+		t.Fatal("The drawn match is not editable")
+	}
+
+	// p3: 1 win 1 draw = 4, p2: 1 win 1 draw 1 loss = 4, p1: 2 draws = 2, p4: 1 loss = 0
+	ranks := finalRanking.TiedRanks()
+	eq1 = len(ranks) == 4
+	eq2 = ranks[0][0].Player == p3 && ranks[1][0].Player == p2
+	eq3 := ranks[2][0].Player == p1 && ranks[3][0].Player == p4
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("The players were not ranked by the points of the points table")
+	}
+
+	finalRanking.PointsTable = &PointsTable{Win: 1, Draw: 2, Loss: 0}
+	tournament.Update(nil)
+
+	ranks = finalRanking.TiedRanks()
+	eq1 = ranks[0][0].Player == p1 && ranks[1][0].Player == p3
+	if !eq1 {
+		t.Fatal("The changed points table did not change the ranks")
+	}
+}
+
+func TestRoundRobinPointsTable(t *testing.T) {
+	players, err := PlayerSlice(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+	p3 := players[2]
+
+	pointsTable := NewPointsTable(2, 0, 1).WithWalkoverPoints(2, 0)
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewPointsTableRoundRobin(entries, 1, NewScore(21, 0), pointsTable)
+
+	matches := tournament.matchList.Matches
+	finalRanking := tournament.FinalRanking
+
+	playMatch(matches, p2, p1)
+	tournament.WithdrawPlayer(p3)
+	tournament.Update(nil)
+
+	metrics1 := finalRanking.Metrics[p1]
+	metrics2 := finalRanking.Metrics[p2]
+	metrics3 := finalRanking.Metrics[p3]
+
+	eq1 := metrics2.Points == 4 && metrics2.WalkoverWins == 1
+	eq2 := metrics1.Points == 3 && metrics1.WalkoverWins == 1 && metrics1.WalkoverLosses == 0
+	eq3 := metrics3.Points == 0 && metrics3.WalkoverLosses == 2 && metrics3.Losses == 2
+	if !eq1 || !eq2 || !eq3 {
+		t.Fatal("The points of the points table are not correct")
+	}
+
+	ranks := finalRanking.TiedRanks()
+	eq1 = ranks[0][0].Player == p2 && ranks[1][0].Player == p1 && ranks[2][0].Player == p3
+	if !eq1 {
+		t.Fatal("The players were not ranked by their points")
+	}
+
+	walkoverMetrics := &MatchMetrics{Wins: 1, WalkoverWins: 1, Losses: 1, WalkoverLosses: 1}
+	defaultTable := PointsTable{Win: 3, Draw: 1, Loss: 1}
+	if defaultTable.Points(walkoverMetrics) != 4 {
+		t.Fatal("The default points table does not award walkovers like regular results")
+	}
+}
+
+func TestRoundRobinTermination(t *testing.T) {
+	players, err := PlayerSlice(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+	p3 := players[2]
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	matches := tournament.matchList.Matches
+	finalRanking := tournament.FinalRanking
+
+	retired := findMatch(matches, p1, p2)
+	retired.StartMatch()
+	if retired.Slot1.Player == p1 {
+		retired.Retire(p2, NewScore(11, 15))
+	} else {
+		retired.Retire(p2, NewScore(15, 11))
+	}
+
+	disqualified := findMatch(matches, p1, p3)
+	disqualified.StartMatch()
+	disqualified.Disqualify(p3, nil)
+	tournament.Update(nil)
+
+	metrics1 := finalRanking.Metrics[p1]
+	metrics2 := finalRanking.Metrics[p2]
+	eq1 := metrics1.Wins == 2 && metrics1.WalkoverWins == 0 && metrics2.Losses == 1
+	eq2 := metrics1.PointWins == 11 && metrics1.PointLosses == 15 && metrics1.NumSets == 1
+	if !eq1 || !eq2 || metrics2.Withdrawn {
+		t.Fatal("The played points of the terminated matches were not counted")
+	}
+
+	if len(tournament.EditableMatches()) != 2 {
+		t.Fatal("The terminated matches are not editable")
+	}
+
+	tournament.UseMetricSettings(MetricSettings{Termination: CountWalkoverScore})
+
+	metrics1 = finalRanking.Metrics[p1]
+	metrics3 := finalRanking.Metrics[p3]
+	eq1 = metrics1.Wins == 2 && metrics1.WalkoverWins == 2 && metrics1.PointWins == 42
+	eq2 = metrics3.WalkoverLosses == 1 && !metrics3.Withdrawn
+	if !eq1 || !eq2 {
+		t.Fatal("The terminated matches were not counted like walkovers")
+	}
+}
+
+func TestRoundRobinTerminatedDraw(t *testing.T) {
+	players, err := PlayerSlice(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	tournament.AllowDraws(NewPointsTable(3, 1, 0))
+	finalRanking := tournament.FinalRanking
+
+	retired := findMatch(tournament.matchList.Matches, p1, p2)
+	retired.StartMatch()
+	retired.Retire(p2, NewScore(10, 10))
+	tournament.Update(nil)
+
+	if retired.IsDraw() {
+		t.Fatal("The retirement on a level score is a draw")
+	}
+
+	metrics1 := finalRanking.Metrics[p1]
+	metrics2 := finalRanking.Metrics[p2]
+	eq1 := metrics1.Wins == 1 && metrics1.Draws == 0
+	eq2 := metrics2.Losses == 1 && metrics2.Draws == 0
+	if !eq1 || !eq2 {
+		t.Fatal("The retirement on a level score was counted as a draw")
+	}
+
+	ranks := finalRanking.TiedRanks()
+	if len(ranks) != 2 || ranks[0][0].Player != p1 {
+		t.Fatal("The opponent of the retired player was not ranked first")
+	}
+}
+
+func TestRoundRobinReplacement(t *testing.T) {
+	players, err := PlayerSlice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reserve := players[4]
+
+	entries := NewConstantRanking(players[:4])
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+
+	tournament.WithdrawPlayer(players[0])
+	tournament.Update(nil)
+
+	_, err = tournament.ReplacePlayer(reserve, players[1])
+	if err != ErrNotEntered {
+		t.Fatal("A player who is not entered was replaced")
+	}
+	_, err = tournament.ReplacePlayer(players[0], players[1])
+	if err != ErrAlreadyEntered {
+		t.Fatal("A player was replaced by an entered player")
+	}
+
+	replaceMatches, err := tournament.ReplacePlayer(players[0], reserve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tournament.Update(nil)
+
+	if len(replaceMatches) != 3 {
+		t.Fatal("The replacement did not take over all matches")
+	}
+	for _, m := range replaceMatches {
+		if !m.ContainsPlayer(reserve) || m.IsWalkover() {
+			t.Fatal("The replacement was not entered into the matches")
+		}
+	}
+
+	_, ok1 := tournament.FinalRanking.Metrics[reserve]
+	_, ok2 := tournament.FinalRanking.Metrics[players[0]]
+	if !ok1 || ok2 {
+		t.Fatal("The ranking did not pick up the replacement")
+	}
+
+	tournament.MatchesOfPlayer(players[1])[0].StartMatch()
+	_, err = tournament.ReplacePlayer(players[1], players[0])
+	if err != ErrReplacementStarted {
+		t.Fatal("A player was replaced after their match started")
+	}
+}
+
+func TestRoundRobinWithdrawalRules(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+	finalRanking := tournament.FinalRanking
+
+	played := findMatch(tournament.matchList.Matches, p1, p2)
+	played.StartMatch()
+	played.EndMatch(NewScore(21, 15))
+
+	tournament.WithdrawPlayer(p1)
+	tournament.Update(nil)
+
+	if finalRanking.Metrics[p2].NumMatches != 1 {
+		t.Fatal("The played match of the withdrawn player did not count")
+	}
+
+	tournament.UseMetricSettings(MetricSettings{Withdrawal: AnnulAllResults})
+
+	eq1 := finalRanking.Metrics[p2].NumMatches == 0
+	eq2 := finalRanking.Metrics[p1].NumMatches == 0 && finalRanking.Metrics[p1].Withdrawn
+	if !eq1 || !eq2 {
+		t.Fatal("The matches of the withdrawn player were not annulled")
+	}
+
+	tournament.UseMetricSettings(MetricSettings{Withdrawal: AnnulBelowPercent, AnnulPercent: 100})
+	if finalRanking.Metrics[p2].NumMatches != 0 {
+		t.Fatal("The matches of a player who did not play all matches were not annulled")
+	}
+
+	tournament.UseMetricSettings(MetricSettings{Withdrawal: AnnulBelowPercent, AnnulPercent: 30})
+	if finalRanking.Metrics[p2].NumMatches != 1 {
+		t.Fatal("The matches of a player who played enough matches were annulled")
+	}
+}
+
+func TestRoundRobinDisqualification(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := players[0]
+	p2 := players[1]
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewRoundRobin(entries, 1, NewScore(21, 0))
+
+	played := findMatch(tournament.matchList.Matches, p1, p2)
+	played.StartMatch()
+	played.EndMatch(NewScore(21, 15))
+
+	disqualifyMatches := tournament.DisqualifyPlayer(p1)
+	tournament.Update(nil)
+
+	if len(disqualifyMatches) != 3 {
+		t.Fatal("Not all matches of the player were marked")
+	}
+	for _, m := range disqualifyMatches {
+		if m != played && !m.IsWalkover() {
+			t.Fatal("The open matches of the player are not walkovers")
+		}
+	}
+
+	ranks := tournament.FinalRanking.Ranks()
+	if len(ranks) != 3 || slices.ContainsFunc(ranks, func(s *Slot) bool { return s.Player == p1 }) {
+		t.Fatal("The disqualified player is still ranked")
+	}
+}
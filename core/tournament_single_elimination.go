@@ -1,6 +1,17 @@
 package core
 
-import "slices"
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrMatchNotEnded      = errors.New("the match has not ended")
+	ErrMatchNotInDraw     = errors.New("the match is not part of the draw")
+	ErrCorrectionWalkover = errors.New("the result of a walkover or bye can not be corrected")
+	ErrCorrectionNoWinner = errors.New("the corrected score does not determine a winner")
+)
 
 type SingleElimination struct {
 	BaseTournament[*EliminationRanking]
@@ -11,6 +22,7 @@ type SingleElimination struct {
 func (t *SingleElimination) initTournament(
 	entries Ranking,
 	seeded bool,
+	numWinners int,
 	rankingGraph *RankingGraph,
 ) error {
 	if len(entries.Ranks()) < 2 {
@@ -30,7 +42,9 @@ func (t *SingleElimination) initTournament(
 	balancedEntries := NewBalancedRanking(entries, rankingGraph)
 	entrySlots := balancedEntries.Ranks()
 
-	numRounds := getNumRounds(len(entrySlots))
+	// The draw stops early when it has more than one winner
+	// (e.g. the qualifiers of a qualifying draw)
+	numRounds := getNumRounds(len(entrySlots)) - getNumRounds(numWinners)
 
 	rounds := make([]*Round, 0, numRounds)
 	for i := range numRounds {
@@ -54,7 +68,7 @@ func (t *SingleElimination) initTournament(
 		}
 	}
 
-	numMatches := getNumMatches(numRounds)
+	numMatches := getNumMatches(numRounds) * numWinners
 	matches := make([]*Match, 0, numMatches)
 	for _, r := range rounds {
 		matches = append(matches, r.Matches...)
@@ -62,8 +76,11 @@ func (t *SingleElimination) initTournament(
 
 	matchList := &matchList{Matches: matches, Rounds: rounds}
 
-	finals := matches[len(matches)-1]
-	finalsRanking := []Ranking{t.WinnerRankings[finals]}
+	finals := rounds[len(rounds)-1].Matches
+	finalsRanking := make([]Ranking, 0, len(finals))
+	for _, m := range finals {
+		finalsRanking = append(finalsRanking, t.WinnerRankings[m])
+	}
 	finalRanking := NewEliminationRanking(matchList, entries, finalsRanking, rankingGraph)
 
 	t.addTournamentData(matchList, rankingGraph, finalRanking)
@@ -260,7 +277,113 @@ func (e *EliminationEditingPolicy) isEditable(match *Match) bool {
 	return editable
 }
 
+// The CorrectionReport lists the consequences of a forced
+// result correction (see [EliminationEditingPolicy.ForceCorrect])
+type CorrectionReport struct {
+	// The corrected match
+	Match *Match
+
+	// True when the correction changed the winner of the match
+	WinnerChanged bool
+
+	// The dependent matches whose participants change
+	// because of the correction
+	Invalidated []*Match
+
+	// The invalidated matches that had already started.
+	// Their results were reset.
+	Reset []*Match
+}
+
+// Changes the result of an ended match even when a following
+// match already started.
+//
+// When the winner changes, the participants of the dependent
+// matches in the elimination graph change as well. Their results
+// are reset and they are listed in the returned report. Dependent
+// matches that were not decided yet keep their state since the
+// matches after them are not affected.
+// The tournament has to be updated afterwards to propagate
+// the correction.
+func (e *EliminationEditingPolicy) ForceCorrect(match *Match, score Score) (*CorrectionReport, error) {
+	if !slices.Contains(e.matchList.Matches, match) {
+		return nil, ErrMatchNotInDraw
+	}
+	if match.EndTime.IsZero() {
+		return nil, ErrMatchNotEnded
+	}
+	if match.IsWalkover() || match.HasBye() {
+		return nil, ErrCorrectionWalkover
+	}
+	if score == nil {
+		return nil, ErrCorrectionNoWinner
+	}
+	if isDrawScore(score) {
+		if !match.AllowDraw {
+			return nil, ErrDrawNotAllowed
+		}
+	} else if _, err := score.GetWinner(); err != nil {
+		return nil, ErrCorrectionNoWinner
+	}
+
+	oldWinner, _ := match.GetWinner()
+
+	match.Score = score
+	match.Termination = NotTerminated
+	match.TerminatedPlayer = nil
+
+	newWinner, _ := match.GetWinner()
+
+	report := &CorrectionReport{
+		Match:         match,
+		WinnerChanged: oldWinner != newWinner,
+		Invalidated:   make([]*Match, 0, 4),
+		Reset:         make([]*Match, 0, 4),
+	}
+
+	if report.WinnerChanged {
+		e.invalidateDependants(match, report)
+	}
+
+	return report, nil
+}
+
+// Adds the dependants of the match to the invalidated matches
+// of the report and resets the ones that started. The dependants
+// of a decided match are invalidated as well because its winner
+// is no longer certain.
+func (e *EliminationEditingPolicy) invalidateDependants(match *Match, report *CorrectionReport) {
+	for _, m := range e.eliminationGraph.GetDependants(match) {
+		if slices.Contains(report.Invalidated, m) {
+			continue
+		}
+		report.Invalidated = append(report.Invalidated, m)
+
+		winner, _ := m.GetWinner()
+		decided := winner != nil
+
+		if !m.StartTime.IsZero() {
+			resetMatch(m)
+			report.Reset = append(report.Reset, m)
+		}
+
+		if decided {
+			e.invalidateDependants(m, report)
+		}
+	}
+}
+
+// Clears the result of the match so it can be played again
+func resetMatch(match *Match) {
+	match.StartTime = time.Time{}
+	match.EndTime = time.Time{}
+	match.Score = nil
+	match.Termination = NotTerminated
+	match.TerminatedPlayer = nil
+}
+
 type EliminationWithdrawalPolicy struct {
+	entries          Ranking
 	matchList        *matchList
 	eliminationGraph *EliminationGraph
 }
@@ -337,12 +460,53 @@ func (w *EliminationWithdrawalPolicy) ListReenterMatches(player Player) []*Match
 	return reenteredMatches
 }
 
+// Replaces the player with the replacement in their entry slot.
+// The matches that the player reached through byes or walkovers
+// are taken over as well. This is refused once one of them started.
+func (w *EliminationWithdrawalPolicy) ReplacePlayer(player, replacement Player) ([]*Match, error) {
+	if dependantMatchesStarted(player, w.matchList, w.eliminationGraph) {
+		return nil, ErrReplacementStarted
+	}
+	return replaceEntry(w.entries, w.matchList, player, replacement)
+}
+
+// Returns true when a match following one of the player's matches
+// has started. The opponents of a withdrawn player advance into
+// these matches through the walkovers.
+func dependantMatchesStarted(player Player, matchList *matchList, eliminationGraph *EliminationGraph) bool {
+	for _, m := range matchList.MatchesOfPlayer(player) {
+		nextMatches := eliminationGraph.nextPlayableMatches(m)
+		if MatchesStarted(nextMatches...) {
+			return true
+		}
+	}
+	return false
+}
+
+// Disqualifies the player from the tournament.
+// The player is withdrawn from their pending match and
+// removed from the final ranking.
+func (w *EliminationWithdrawalPolicy) DisqualifyPlayer(player Player) []*Match {
+	return disqualifyFromMatches(player, w.ListWithdrawMatches(player), w.matchList)
+}
+
 // Implements [KnockOutTournament] interface
 func (t *SingleElimination) getBase() *BaseTournament[*EliminationRanking] {
 	return &t.BaseTournament
 }
 
 func createSingleElimination(entries Ranking, seeded bool, rankingGraph *RankingGraph) (*SingleElimination, error) {
+	return createEliminationDraw(entries, seeded, 1, rankingGraph)
+}
+
+// Creates a single elimination draw that stops when numWinners
+// players are left. A numWinners of 1 is a full single elimination.
+func createEliminationDraw(
+	entries Ranking,
+	seeded bool,
+	numWinners int,
+	rankingGraph *RankingGraph,
+) (*SingleElimination, error) {
 	singleElimination := &SingleElimination{
 		BaseTournament: newBaseTournament[*EliminationRanking](entries),
 	}
@@ -350,6 +514,7 @@ func createSingleElimination(entries Ranking, seeded bool, rankingGraph *Ranking
 	err := singleElimination.initTournament(
 		entries,
 		seeded,
+		numWinners,
 		rankingGraph,
 	)
 	if err != nil {
@@ -365,6 +530,7 @@ func createSingleElimination(entries Ranking, seeded bool, rankingGraph *Ranking
 	}
 
 	withdrawalPolicy := &EliminationWithdrawalPolicy{
+		entries:          singleElimination.Entries,
 		matchList:        matchList,
 		eliminationGraph: eliminationGraph,
 	}
@@ -357,3 +357,204 @@ func TestSingleEliminationEditingPolicy(t *testing.T) {
 		t.Fatal("The two predecessor matches of the started match are still editable")
 	}
 }
+
+func TestSingleEliminationRejectsDraws(t *testing.T) {
+	players, err := PlayerSlice(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewSingleElimination(entries)
+
+	final := tournament.matchList.Matches[0]
+	final.StartMatch()
+	err = final.EndMatch(NewScore(3, 3))
+	tournament.Update(nil)
+
+	eq1 := err == ErrDrawNotAllowed
+	eq2 := len(tournament.FinalRanking.TiedRanks()) == 1
+	if !eq1 || !eq2 {
+		t.Fatal("The elimination match accepted a draw")
+	}
+}
+
+func TestSingleEliminationReplacement(t *testing.T) {
+	players, err := PlayerSlice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reserve := players[4]
+
+	entries := NewConstantRanking(players[:4])
+	tournament, _ := NewSingleElimination(entries)
+
+	semi := tournament.WithdrawPlayer(players[0])[0]
+	tournament.Update(nil)
+	if !semi.IsWalkover() {
+		t.Fatal("The withdrawal did not cause a walkover")
+	}
+
+	_, err = tournament.ReplacePlayer(players[0], reserve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tournament.Update(nil)
+
+	if semi.IsWalkover() || semi.Slot1.Player != reserve {
+		t.Fatal("The replacement did not take the seed of the withdrawn player")
+	}
+
+	semi.StartMatch()
+	semi.EndMatch(NewScore(21, 10))
+	tournament.Update(nil)
+
+	final := tournament.Matches[2]
+	if final.Slot1.Player != reserve {
+		t.Fatal("The replacement did not advance into the final")
+	}
+
+	_, err = tournament.ReplacePlayer(reserve, players[0])
+	if err != ErrReplacementStarted {
+		t.Fatal("A player was replaced after their match started")
+	}
+}
+
+func TestSingleEliminationReplacementAfterWalkover(t *testing.T) {
+	players, err := PlayerSlice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reserve := players[4]
+
+	entries := NewConstantRanking(players[:4])
+	tournament, _ := NewSingleElimination(entries)
+
+	tournament.WithdrawPlayer(players[0])
+	tournament.Update(nil)
+
+	otherSemi := tournament.Matches[1]
+	otherSemi.StartMatch()
+	otherSemi.EndMatch(NewScore(21, 10))
+	tournament.Update(nil)
+
+	final := tournament.Matches[2]
+	final.StartMatch()
+
+	_, err = tournament.ReplacePlayer(players[0], reserve)
+	if err != ErrReplacementStarted {
+		t.Fatal("A player was replaced after their walkover opponent started the next match")
+	}
+	if entrySlotOfPlayer(entries.Ranks(), players[0]) == nil {
+		t.Fatal("The refused replacement changed the entries")
+	}
+}
+
+func TestSingleEliminationDisqualification(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewSingleElimination(entries)
+
+	for _, r := range tournament.Rounds {
+		playBySeed(r.Matches, players)
+		tournament.Update(nil)
+	}
+
+	disqualifyMatches := tournament.DisqualifyPlayer(players[0])
+	tournament.Update(nil)
+
+	if len(disqualifyMatches) != 2 || !disqualifyMatches[1].IsPlayerDisqualified(players[0]) {
+		t.Fatal("The matches of the player were not marked")
+	}
+
+	ranks := tournament.FinalRanking.Ranks()
+	rankedPlayers := make([]Player, 0, len(ranks))
+	for _, s := range ranks {
+		rankedPlayers = append(rankedPlayers, s.Player)
+	}
+
+	if slices.Contains(rankedPlayers, players[0]) {
+		t.Fatal("The disqualified player is still ranked")
+	}
+	if len(rankedPlayers) != 3 || rankedPlayers[0] != players[1] {
+		t.Fatal("The other players did not keep their achieved places")
+	}
+
+	result := tournament.ToMap(func(i int) string { return fmt.Sprint(i) })
+	if !slices.Equal(result["disqualified"].([]string), []string{players[0].Id()}) {
+		t.Fatal("The disqualification was not marshalled")
+	}
+}
+
+func TestSingleEliminationForceCorrect(t *testing.T) {
+	players, err := PlayerSlice(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewSingleElimination(entries)
+	ep := tournament.EditingPolicy.(*EliminationEditingPolicy)
+
+	final := tournament.Rounds[2].Matches[0]
+	_, err = ep.ForceCorrect(final, NewScore(21, 10))
+	if err != ErrMatchNotEnded {
+		t.Fatal("A match that did not end was corrected")
+	}
+
+	for _, r := range tournament.Rounds {
+		playBySeed(r.Matches, players)
+		tournament.Update(nil)
+	}
+
+	quarter := tournament.MatchesOfPlayer(players[0])[0]
+	semi := tournament.MatchesOfPlayer(players[0])[1]
+	if slices.Contains(ep.EditableMatches(), quarter) {
+		t.Fatal("The quarter final is editable after the semi final started")
+	}
+
+	sameWinner := NewScore(21, 5)
+	if quarter.Slot1.Player != players[0] {
+		sameWinner = NewScore(5, 21)
+	}
+	report, err := ep.ForceCorrect(quarter, sameWinner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.WinnerChanged || len(report.Invalidated) != 0 {
+		t.Fatal("A correction that kept the winner invalidated matches")
+	}
+
+	_, err1 := ep.ForceCorrect(quarter, nil)
+	_, err2 := ep.ForceCorrect(quarter, NewScore(21, 21))
+	if err1 != ErrCorrectionNoWinner || err2 != ErrDrawNotAllowed || quarter.Score != sameWinner {
+		t.Fatal("A score without a winner was accepted as a correction")
+	}
+
+	otherWinner := NewScore(sameWinner.Points2()[0], sameWinner.Points1()[0])
+	report, err = ep.ForceCorrect(quarter, otherWinner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tournament.Update(nil)
+
+	eq1 := report.WinnerChanged && slices.Equal(report.Invalidated, []*Match{semi, final})
+	eq2 := slices.Equal(report.Reset, []*Match{semi, final})
+	if !eq1 || !eq2 {
+		t.Fatal("The dependent matches were not invalidated")
+	}
+
+	opponent := quarter.Slot2.Player
+	if opponent == players[0] {
+		opponent = quarter.Slot1.Player
+	}
+	eq1 = semi.ContainsPlayer(opponent) && !semi.ContainsPlayer(players[0])
+	eq2 = semi.Score == nil && semi.StartTime.IsZero() && final.Slot1.Player == nil
+	if !eq1 || !eq2 {
+		t.Fatal("The correction did not propagate to the dependent matches")
+	}
+}
@@ -266,6 +266,7 @@ func createSingleEliminationWithConsolation(
 	}
 
 	withdrawalPolicy := &EliminationWithdrawalPolicy{
+		entries:          consolationTournament.Entries,
 		matchList:        matchList,
 		eliminationGraph: eliminationGraph,
 	}
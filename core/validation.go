@@ -0,0 +1,199 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dominikbraun/graph"
+)
+
+var (
+	ErrUnresolvedPlacement = errors.New("a placement refers to a ranking that is not in the ranking graph")
+	ErrRankingCycle        = errors.New("the ranking graph has a cycle")
+	ErrDoubleBooking       = errors.New("a player appears twice in a round")
+	ErrIncompleteResult    = errors.New("an ended match has no start time or score")
+	ErrWalkoverOccupant    = errors.New("a withdrawn player does not occupy the match")
+	ErrFinalRankingEntries = errors.New("the final ranking does not contain each entry exactly once")
+)
+
+// Checks the invariants of the tournament and returns the
+// violations joined into one error. Returns nil when the
+// tournament is consistent.
+//
+// The checks are meant for tests and debugging since they
+// traverse the whole tournament.
+func (t *BaseTournament[_]) Validate() error {
+	violations := make([]error, 0)
+
+	violations = append(violations, t.validatePlacements()...)
+	violations = append(violations, t.validateRankingGraph()...)
+	violations = append(violations, t.validateRounds()...)
+	violations = append(violations, t.validateMatches()...)
+	violations = append(violations, t.validateFinalRanking()...)
+
+	return errors.Join(violations...)
+}
+
+// Returns a map describing the result of [BaseTournament.Validate]
+// for debugging purposes
+func (t *BaseTournament[_]) DebugMap() map[string]any {
+	violations := make([]string, 0)
+
+	err := t.Validate()
+	if err != nil {
+		for _, v := range err.(interface{ Unwrap() []error }).Unwrap() {
+			violations = append(violations, v.Error())
+		}
+	}
+
+	numRankings, _ := t.RankingGraph.Order()
+
+	return map[string]any{
+		"valid":       err == nil,
+		"violations":  violations,
+		"numRankings": numRankings,
+		"numMatches":  len(t.Matches),
+		"numRounds":   len(t.Rounds),
+	}
+}
+
+func (t *BaseTournament[_]) validatePlacements() []error {
+	violations := make([]error, 0)
+
+	checkSlot := func(s *Slot) {
+		if s.Placement == nil {
+			return
+		}
+		ranking := s.Placement.Ranking()
+		if _, err := t.RankingGraph.Vertex(ranking.Id()); err != nil {
+			violations = append(violations, fmt.Errorf("%w: slot %d", ErrUnresolvedPlacement, s.Id))
+		}
+	}
+
+	for _, ranking := range t.RankingGraph.Nodes() {
+		for _, s := range ranking.dependantSlots() {
+			checkSlot(s)
+		}
+	}
+
+	for _, m := range t.Matches {
+		for s := range m.Slots {
+			checkSlot(s)
+		}
+	}
+
+	return violations
+}
+
+func (t *BaseTournament[_]) validateRankingGraph() []error {
+	_, err := graph.TopologicalSort(t.RankingGraph.Graph)
+	if err != nil {
+		return []error{ErrRankingCycle}
+	}
+	return nil
+}
+
+func (t *BaseTournament[_]) validateRounds() []error {
+	violations := make([]error, 0)
+
+	for i, r := range t.Rounds {
+		seen := make(map[string]bool)
+		for _, m := range r.Matches {
+			for s := range m.Slots {
+				if s.Player == nil || s.IsBye() {
+					continue
+				}
+				for _, p := range individualsOfPlayer(s.Player) {
+					if seen[p.Id()] {
+						violations = append(violations, fmt.Errorf("%w: player %s in round %d", ErrDoubleBooking, p.Id(), i))
+					}
+					seen[p.Id()] = true
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+func (t *BaseTournament[_]) validateMatches() []error {
+	violations := make([]error, 0)
+
+	for _, m := range t.Matches {
+		if !m.EndTime.IsZero() {
+			noScore := m.Score == nil && !m.IsTerminated()
+			if m.StartTime.IsZero() || noScore {
+				violations = append(violations, fmt.Errorf("%w: match %v", ErrIncompleteResult, m))
+			}
+		}
+
+		for _, p := range m.WithdrawnPlayers {
+			if !m.ContainsPlayer(p) {
+				violations = append(violations, fmt.Errorf("%w: player %s in match %v", ErrWalkoverOccupant, p.Id(), m))
+			}
+		}
+	}
+
+	return violations
+}
+
+// A ranking that keeps track of disqualifications
+// that are not recorded in the matches
+type disqualifyingRanking interface {
+	// Returns the players who were disqualified
+	disqualifiedPlayers() []Player
+}
+
+func (t *BaseTournament[_]) validateFinalRanking() []error {
+	violations := make([]error, 0)
+
+	disqualified := DisqualifiedPlayers(t.Matches)
+	if ranking, ok := any(t.FinalRanking).(disqualifyingRanking); ok {
+		disqualified = append(disqualified, ranking.disqualifiedPlayers()...)
+	}
+
+	counts := make(map[Player]int)
+	for _, s := range t.FinalRanking.Ranks() {
+		if s.Player != nil && !s.IsBye() {
+			counts[s.Player] += 1
+		}
+	}
+
+	for _, s := range t.Entries.Ranks() {
+		if s.Player == nil || s.IsBye() {
+			continue
+		}
+
+		expected := 1
+		if slices.Contains(disqualified, s.Player) {
+			expected = 0
+		}
+
+		if counts[s.Player] != expected {
+			violations = append(violations, fmt.Errorf("%w: player %s is ranked %d times", ErrFinalRankingEntries, s.Player.Id(), counts[s.Player]))
+		}
+		delete(counts, s.Player)
+	}
+
+	for _, s := range t.FinalRanking.Ranks() {
+		count, ok := counts[s.Player]
+		if !ok {
+			continue
+		}
+		violations = append(violations, fmt.Errorf("%w: player %s is ranked %d times without entry", ErrFinalRankingEntries, s.Player.Id(), count))
+		delete(counts, s.Player)
+	}
+
+	return violations
+}
+
+// Returns the members of a composite player or
+// the player itself
+func individualsOfPlayer(player Player) []Player {
+	composite, ok := player.(CompositePlayer)
+	if !ok {
+		return []Player{player}
+	}
+	return composite.Members()
+}
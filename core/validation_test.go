@@ -0,0 +1,119 @@
+package core
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestValidateTournaments(t *testing.T) {
+	players, err := PlayerSlice(14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := NewConstantRanking(players[:8])
+
+	builders := map[string]func() (Tournament, error){
+		"SingleElimination": func() (Tournament, error) {
+			return NewSingleElimination(entries)
+		},
+		"Consolation": func() (Tournament, error) {
+			return NewSingleEliminationWithConsolation(entries, 1, 4)
+		},
+		"DoubleElimination": func() (Tournament, error) {
+			return NewDoubleElimination(entries)
+		},
+		"RoundRobin": func() (Tournament, error) {
+			return NewRoundRobin(entries, 1, NewScore(21, 0))
+		},
+		"GroupKnockout": func() (Tournament, error) {
+			return NewGroupKnockout(entries, NewGroupKnockoutSingleElimination, 2, 4, NewScore(21, 0))
+		},
+		"Americano": func() (Tournament, error) {
+			return NewAmericano(entries, 0, NewScore(21, 0))
+		},
+		"Ladder": func() (Tournament, error) {
+			return NewLadder(entries, LadderSettings{MaxChallengeDistance: 2})
+		},
+		"QualifyingMainDraw": func() (Tournament, error) {
+			return NewQualifyingMainDraw(NewConstantRanking(players[:6]), NewConstantRanking(players[6:]), 2)
+		},
+	}
+
+	settings := &SimulationSettings{ScoreGenerator: testScoreGenerator}
+
+	for name, build := range builders {
+		tournament, err := build()
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		err = tournament.Validate()
+		if err != nil {
+			t.Fatal(name, "is invalid after creation:", err)
+		}
+
+		simulateMatches(tournament, rand.New(rand.NewSource(0)), settings)
+
+		err = tournament.Validate()
+		if err != nil {
+			t.Fatal(name, "is invalid after playing:", err)
+		}
+	}
+}
+
+func TestValidateViolations(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewSingleElimination(entries)
+
+	semi1 := tournament.Matches[0]
+	semi2 := tournament.Matches[1]
+
+	semi1.EndTime = time.Now()
+	semi1.WithdrawnPlayers = append(semi1.WithdrawnPlayers, semi2.Slot1.Player)
+	semi2.Slot2.Player = semi1.Slot1.Player
+
+	err = tournament.Validate()
+
+	eq1 := errors.Is(err, ErrIncompleteResult)
+	eq2 := errors.Is(err, ErrWalkoverOccupant)
+	eq3 := errors.Is(err, ErrDoubleBooking)
+	eq4 := errors.Is(err, ErrFinalRankingEntries)
+	if !eq1 || !eq2 || !eq3 || !eq4 {
+		t.Fatal("The violations were not detected")
+	}
+
+	debug := tournament.DebugMap()
+	if debug["valid"].(bool) || len(debug["violations"].([]string)) != 5 {
+		t.Fatal("The debug map does not report the violations")
+	}
+
+	tournament.RankingGraph.AddEdge(tournament.FinalRanking, tournament.Entries)
+	if !errors.Is(tournament.Validate(), ErrRankingCycle) {
+		t.Fatal("The cycle in the ranking graph was not detected")
+	}
+}
+
+func TestValidateLadderDisqualification(t *testing.T) {
+	players, err := PlayerSlice(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries := NewConstantRanking(players)
+	tournament, _ := NewLadder(entries, LadderSettings{MaxChallengeDistance: 2})
+
+	tournament.DisqualifyPlayer(players[1])
+	tournament.Update(nil)
+
+	err = tournament.Validate()
+	if err != nil {
+		t.Fatal("The ladder is invalid after a disqualification:", err)
+	}
+}
@@ -1,6 +1,14 @@
 package core
 
-import "slices"
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrNotEntered         = errors.New("the player is not entered in the tournament")
+	ErrReplacementStarted = errors.New("a match of the replaced player has already started")
+)
 
 // The WithdrawalPolicy dictates how a player can
 // withdraw from a tournament and also if a player
@@ -23,16 +31,133 @@ type WithdrawalPolicy interface {
 	// Lists the matches that a player would reenter into
 	// if ReenterPlayer was called
 	ListReenterMatches(player Player) []*Match
+
+	// Replaces the player with the replacement in their entry
+	// slot. This is refused once a match of the player has started.
+	// The matches that the replacement took over are returned.
+	// The tournament has to be updated afterwards to propagate
+	// the replacement.
+	ReplacePlayer(player, replacement Player) ([]*Match, error)
+
+	// Disqualifies the player from the tournament.
+	// Unlike a withdrawn player who keeps their achieved place,
+	// the disqualified player is withdrawn from the remaining
+	// matches and removed from the final ranking.
+	// The matches that the player is marked as disqualified
+	// in are returned.
+	DisqualifyPlayer(player Player) []*Match
 }
 
+// Adds the player to the withdrawn players of the matches.
+// When the player is a member of a composite player, the
+// composite player is withdrawn.
 func withdrawFromMatches(player Player, withdrawMatches []*Match) {
 	for _, m := range withdrawMatches {
-		m.WithdrawnPlayers = append(m.WithdrawnPlayers, player)
+		m.WithdrawnPlayers = append(m.WithdrawnPlayers, entryOfPlayer(m, player))
+	}
+}
+
+// Returns the player in the slots of the match that
+// is or contains the given player
+func entryOfPlayer(m *Match, player Player) Player {
+	for slot := range m.Slots {
+		if slot.Player != nil && matchesPlayer(slot.Player, player) {
+			return slot.Player
+		}
+	}
+	return player
+}
+
+// Withdraws the player from the withdrawMatches that they are
+// not already withdrawn from and marks the player as disqualified
+// in all their matches. The marked matches are returned.
+func disqualifyFromMatches(player Player, withdrawMatches []*Match, matchList *matchList) []*Match {
+	withdrawMatches = slices.DeleteFunc(
+		slices.Clone(withdrawMatches),
+		func(m *Match) bool { return m.IsPlayerWithdrawn(player) },
+	)
+	withdrawFromMatches(player, withdrawMatches)
+
+	playerMatches := matchList.MatchesOfPlayer(player)
+	for _, m := range playerMatches {
+		if !m.IsPlayerDisqualified(player) {
+			m.DisqualifiedPlayers = append(m.DisqualifiedPlayers, entryOfPlayer(m, player))
+		}
+	}
+
+	return playerMatches
+}
+
+// Removes the slots of the disqualified players from the ranks
+func removeDisqualified(ranks [][]*Slot, disqualified []Player) [][]*Slot {
+	if len(disqualified) == 0 {
+		return ranks
+	}
+
+	cleanedRanks := make([][]*Slot, 0, len(ranks))
+	for _, r := range ranks {
+		cleanedRank := slices.DeleteFunc(slices.Clone(r), func(s *Slot) bool {
+			return s.Player != nil && slices.Contains(disqualified, s.Player)
+		})
+		if len(cleanedRank) > 0 {
+			cleanedRanks = append(cleanedRanks, cleanedRank)
+		}
 	}
+	return cleanedRanks
 }
 
 func reenterIntoMatches(player Player, reenterMatches []*Match) {
 	for _, m := range reenterMatches {
-		m.WithdrawnPlayers = slices.DeleteFunc(m.WithdrawnPlayers, func(p Player) bool { return p.Id() == player.Id() })
+		m.WithdrawnPlayers = slices.DeleteFunc(m.WithdrawnPlayers, func(p Player) bool { return matchesPlayer(p, player) })
+	}
+}
+
+// Swaps the player in their entry slot for the replacement.
+//
+// The replacement takes over the matches of the player when
+// none of them have started yet. Withdrawals of the player from
+// those matches are removed so that the replacement can play.
+func replaceEntry(
+	entries Ranking,
+	matchList *matchList,
+	player, replacement Player,
+) ([]*Match, error) {
+	entrySlot, err := replaceableEntrySlot(entries.Ranks(), player, replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	replaceMatches := matchList.MatchesOfPlayer(player)
+	if MatchesStarted(replaceMatches...) {
+		return nil, ErrReplacementStarted
+	}
+
+	reenterIntoMatches(player, replaceMatches)
+	entrySlot.Player = replacement
+
+	return replaceMatches, nil
+}
+
+// Returns the entry slot of the player when the player is
+// entered and the replacement is not
+func replaceableEntrySlot(entrySlots []*Slot, player, replacement Player) (*Slot, error) {
+	entrySlot := entrySlotOfPlayer(entrySlots, player)
+	if entrySlot == nil {
+		return nil, ErrNotEntered
+	}
+	if entrySlotOfPlayer(entrySlots, replacement) != nil {
+		return nil, ErrAlreadyEntered
+	}
+	return entrySlot, nil
+}
+
+// Returns the slot that the player is directly
+// entered in or nil
+func entrySlotOfPlayer(entrySlots []*Slot, player Player) *Slot {
+	for _, s := range entrySlots {
+		if s.Placement == nil && s.Player != nil && s.Player.Id() == player.Id() {
+			return s
+		}
 	}
+	return nil
 }
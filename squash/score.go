@@ -0,0 +1,82 @@
+package squash
+
+import (
+	"errors"
+
+	"github.com/ezBadminton/gotournament/badminton"
+)
+
+// The points that win a game with point-a-rally scoring (PAR-11).
+// At 10-10 the game continues until one player leads by two points.
+const WinningPoints = 11
+
+// PAR-11 is the same scoring system as the badminton
+// [badminton.TableTennisLike] preset with a configurable
+// number of games. The validation and its errors are shared.
+var (
+	ErrBestOf = errors.New("the number of sets is not odd and positive")
+
+	ErrUndetermined = badminton.ErrUndetermined
+
+	ErrEmpty           = badminton.ErrEmpty
+	ErrUndeterminedSet = badminton.ErrUndeterminedSet
+	ErrUnequalSets     = badminton.ErrUnequalSets
+	ErrTooManySets     = badminton.ErrTooManySets
+	ErrTooFewSets      = badminton.ErrTooFewSets
+	ErrNegativePoints  = badminton.ErrNegativePoints
+	ErrTooFewPoints    = badminton.ErrTooFewPoints
+	ErrInvalidMargin   = badminton.ErrInvalidMargin
+	ErrUnneededSets    = badminton.ErrUnneededSets
+	ErrEqualSetWins    = badminton.ErrEqualSetWins
+)
+
+// The games of squash are modeled as the sets of the score
+type ScoreSettings struct {
+	// The maximum number of games in a match
+	// (e.g. 5 for best of 5)
+	BestOf int
+}
+
+var (
+	BestOf3 = ScoreSettings{BestOf: 3}
+	BestOf5 = ScoreSettings{BestOf: 5}
+)
+
+func NewScoreSettings(bestOf int) (ScoreSettings, error) {
+	scoreSettings := ScoreSettings{BestOf: bestOf}
+	if bestOf <= 0 || bestOf%2 == 0 {
+		return scoreSettings, ErrBestOf
+	}
+	return scoreSettings, nil
+}
+
+// Returns the number of games that win the match
+func (s ScoreSettings) WinningSets() int {
+	return s.BestOf/2 + 1
+}
+
+// Returns the equivalent badminton score settings
+func (s ScoreSettings) Badminton() badminton.ScoreSettings {
+	settings := badminton.TableTennisLike
+	settings.WinningSets = s.WinningSets()
+	return settings
+}
+
+type Score = badminton.Score
+
+// Creates a validated squash score.
+//
+// Each game is won with 11 points and a margin of at least two
+// points or, after 10-10, with a margin of exactly two points.
+func NewScore(
+	a, b []int,
+	settings ScoreSettings,
+) (*Score, error) {
+	return badminton.NewScore(a, b, settings.Badminton())
+}
+
+// Returns the score of a match that the first opponent
+// won without losing a point (used for walkovers)
+func MaxScore(settings ScoreSettings) *Score {
+	return badminton.MaxScore(settings.Badminton())
+}
@@ -0,0 +1,61 @@
+package squash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScoreSettings(t *testing.T) {
+	_, err := NewScoreSettings(2)
+	if err != ErrBestOf {
+		t.Fatal("an even number of games did not error")
+	}
+
+	settings, err := NewScoreSettings(5)
+	if err != nil || settings.WinningSets() != 3 {
+		t.Fatal("best of 5 does not need 3 games to win")
+	}
+}
+
+func TestScoreErrors(t *testing.T) {
+	tests := []struct {
+		a, b []int
+		err  error
+	}{
+		{[]int{}, []int{}, ErrEmpty},
+		{[]int{11, 11, 11}, []int{5, 5}, ErrUnequalSets},
+		{[]int{11, 11}, []int{5, 5}, ErrTooFewSets},
+		{[]int{11, 11, 5, 5, 11, 5}, []int{5, 5, 11, 11, 5, 11}, ErrTooManySets},
+		{[]int{11, 11, 11, 5}, []int{5, 5, 5, 11}, ErrUnneededSets},
+		{[]int{11, 11, 11}, []int{11, 5, 5}, ErrUndeterminedSet},
+		{[]int{9, 11, 11}, []int{7, 5, 5}, ErrTooFewPoints},
+		{[]int{12, 11, 11}, []int{9, 5, 5}, ErrInvalidMargin},
+	}
+
+	for _, test := range tests {
+		_, err := NewScore(test.a, test.b, BestOf5)
+		if err != test.err {
+			t.Fatalf("%v - %v returned the wrong error: %v", test.a, test.b, err)
+		}
+	}
+}
+
+func TestValidScores(t *testing.T) {
+	score, err := NewScore([]int{11, 7, 12, 20}, []int{4, 11, 10, 18}, BestOf5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	winner, _ := score.GetWinner()
+	if winner != 0 {
+		t.Fatal("score returned the wrong winner")
+	}
+}
+
+func TestMaxScore(t *testing.T) {
+	score := MaxScore(BestOf3)
+	a := []int{11, 11}
+	b := []int{0, 0}
+	if !reflect.DeepEqual(score.Points1(), a) || !reflect.DeepEqual(score.Points2(), b) {
+		t.Fatal("max score is incorrect")
+	}
+}
@@ -0,0 +1,83 @@
+package tabletennis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScoreSettings(t *testing.T) {
+	_, err := NewScoreSettings(4)
+	if err != ErrBestOf {
+		t.Fatal("an even number of sets did not error")
+	}
+
+	_, err = NewScoreSettings(0)
+	if err != ErrBestOf {
+		t.Fatal("zero sets did not error")
+	}
+
+	settings, err := NewScoreSettings(7)
+	if err != nil || settings.WinningSets() != 4 {
+		t.Fatal("best of 7 does not need 4 sets to win")
+	}
+}
+
+func TestScoreErrors(t *testing.T) {
+	tests := []struct {
+		a, b []int
+		err  error
+	}{
+		{[]int{}, []int{}, ErrEmpty},
+		{[]int{11, 11}, []int{5}, ErrUnequalSets},
+		{[]int{11, 11}, []int{5, 5}, ErrTooFewSets},
+		{[]int{11, 11, 5, 5, 11, 5}, []int{5, 5, 11, 11, 5, 11}, ErrTooManySets},
+		{[]int{11, 11, 11, 5}, []int{5, 5, 5, 11}, ErrUnneededSets},
+		{[]int{11, 11, 11}, []int{11, 5, 5}, ErrUndeterminedSet},
+		{[]int{11, 11, 11}, []int{-1, 5, 5}, ErrNegativePoints},
+		{[]int{10, 11, 11}, []int{8, 5, 5}, ErrTooFewPoints},
+		{[]int{11, 11, 11}, []int{10, 5, 5}, ErrInvalidMargin},
+		{[]int{14, 11, 11}, []int{11, 5, 5}, ErrInvalidMargin},
+		{[]int{11, 5, 11, 5}, []int{5, 11, 5, 11}, ErrEqualSetWins},
+	}
+
+	for _, test := range tests {
+		_, err := NewScore(test.a, test.b, BestOf5)
+		if err != test.err {
+			t.Fatalf("%v - %v returned the wrong error: %v", test.a, test.b, err)
+		}
+	}
+}
+
+func TestValidScores(t *testing.T) {
+	score, err := NewScore([]int{11, 15, 9, 11}, []int{9, 13, 11, 0}, BestOf5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	winner, _ := score.GetWinner()
+	if winner != 0 {
+		t.Fatal("score returned the wrong winner")
+	}
+
+	score, err = NewScore([]int{5, 5, 5, 11, 11, 11, 3}, []int{11, 11, 11, 5, 5, 5, 11}, BestOf7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	winner, _ = score.Invert().GetWinner()
+	if winner != 0 {
+		t.Fatal("the inverted score returned the wrong winner")
+	}
+}
+
+func TestMaxScore(t *testing.T) {
+	score := MaxScore(BestOf7)
+	a := []int{11, 11, 11, 11}
+	b := []int{0, 0, 0, 0}
+	if !reflect.DeepEqual(score.Points1(), a) || !reflect.DeepEqual(score.Points2(), b) {
+		t.Fatal("max score is incorrect")
+	}
+
+	_, err := NewScore(score.Points1(), score.Points2(), BestOf7)
+	if err != nil {
+		t.Fatal("max score is invalid")
+	}
+}
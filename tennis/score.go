@@ -0,0 +1,336 @@
+package tennis
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ezBadminton/gotournament/core"
+)
+
+var (
+	ErrSetsZero     = errors.New("winning sets are zero or less")
+	ErrGamesZero    = errors.New("set games are zero or less")
+	ErrTiebreakZero = errors.New("tiebreak points are zero or less")
+
+	ErrUndetermined = errors.New("the winner is undeterminable from the score")
+
+	ErrEmpty              = errors.New("empty score")
+	ErrTooManySets        = errors.New("too many sets")
+	ErrTooFewSets         = errors.New("too few sets")
+	ErrNegativePoints     = errors.New("negative games or points")
+	ErrUndeterminedSet    = errors.New("a set has equal games")
+	ErrTooFewGames        = errors.New("set winner games are less than the set games")
+	ErrTooManyGames       = errors.New("set winner games exceed the games of a tiebreak set")
+	ErrInvalidMargin      = errors.New("the winning game margin is invalid")
+	ErrInvalidTiebreak    = errors.New("the tiebreak points are invalid")
+	ErrUnexpectedTiebreak = errors.New("a set without a tiebreak has tiebreak points")
+	ErrMatchTiebreakGames = errors.New("a match tiebreak has games")
+	ErrUnneededSets       = errors.New("score contains unneeded extra sets")
+	ErrEqualSetWins       = errors.New("both opponents won an equal number of sets")
+)
+
+type ScoreSettings struct {
+	WinningSets int
+
+	// The games that win a set. At Games-Games (e.g. 6-6)
+	// a tiebreak decides the set.
+	Games int
+
+	// The points that win a tiebreak (with a margin of two points)
+	TiebreakPoints int
+
+	// When not 0 the deciding set is replaced by a match
+	// tiebreak (super tiebreak) to these points
+	MatchTiebreakPoints int
+}
+
+var (
+	// Best of 3 sets to 6 games with a tiebreak to 7 at 6-6
+	BestOf3 = ScoreSettings{WinningSets: 2, Games: 6, TiebreakPoints: 7}
+	// Best of 3 sets where the third set is a match tiebreak to 10
+	BestOf3MatchTiebreak = ScoreSettings{WinningSets: 2, Games: 6, TiebreakPoints: 7, MatchTiebreakPoints: 10}
+	// Best of 5 sets to 6 games with a tiebreak to 7 at 6-6
+	BestOf5 = ScoreSettings{WinningSets: 3, Games: 6, TiebreakPoints: 7}
+)
+
+func NewScoreSettings(
+	winningSets, games, tiebreakPoints, matchTiebreakPoints int,
+) (ScoreSettings, error) {
+	scoreSettings := ScoreSettings{
+		winningSets, games, tiebreakPoints, matchTiebreakPoints,
+	}
+
+	if winningSets <= 0 {
+		return scoreSettings, ErrSetsZero
+	}
+	if games <= 0 {
+		return scoreSettings, ErrGamesZero
+	}
+	if tiebreakPoints <= 0 || matchTiebreakPoints < 0 {
+		return scoreSettings, ErrTiebreakZero
+	}
+
+	return scoreSettings, nil
+}
+
+// A Set of a tennis match
+type Set struct {
+	Games1, Games2 int
+
+	// The points of the tiebreak when the set was decided by one
+	// (e.g. 7-6) or the points of a match tiebreak.
+	// Are 0 otherwise.
+	Tiebreak1, Tiebreak2 int
+
+	// True when the set is a match tiebreak. This is set by NewScore.
+	MatchTiebreak bool
+}
+
+// Returns 0 or 1 whether the first or the second opponent won the set
+func (s Set) winner() int {
+	if s.MatchTiebreak {
+		if s.Tiebreak1 > s.Tiebreak2 {
+			return 0
+		}
+		return 1
+	}
+	if s.Games1 > s.Games2 {
+		return 0
+	}
+	return 1
+}
+
+func (s Set) invert() Set {
+	return Set{
+		Games1:        s.Games2,
+		Games2:        s.Games1,
+		Tiebreak1:     s.Tiebreak2,
+		Tiebreak2:     s.Tiebreak1,
+		MatchTiebreak: s.MatchTiebreak,
+	}
+}
+
+// Formats the set like 6-4, 7-6(5) or [10-8]
+func (s Set) String() string {
+	if s.MatchTiebreak {
+		return fmt.Sprintf("[%d-%d]", s.Tiebreak1, s.Tiebreak2)
+	}
+	if s.Tiebreak1 != 0 || s.Tiebreak2 != 0 {
+		return fmt.Sprintf("%d-%d(%d)", s.Games1, s.Games2, min(s.Tiebreak1, s.Tiebreak2))
+	}
+	return fmt.Sprintf("%d-%d", s.Games1, s.Games2)
+}
+
+var _ core.Score = (*Score)(nil)
+
+// A tennis Score.
+//
+// Points1 and Points2 are the games of each set. This way the
+// match metrics count the games as points and the games
+// difference breaks ties after the set difference.
+// A match tiebreak counts as one game for its winner.
+type Score struct {
+	sets []Set
+}
+
+// Returns the sets of the score
+func (s *Score) Sets() []Set {
+	sets := make([]Set, len(s.sets))
+	copy(sets, s.sets)
+	return sets
+}
+
+func (s *Score) Points1() []int {
+	return s.games(0)
+}
+
+func (s *Score) Points2() []int {
+	return s.games(1)
+}
+
+func (s *Score) games(opponent int) []int {
+	games := make([]int, 0, len(s.sets))
+	for _, set := range s.sets {
+		switch {
+		case set.MatchTiebreak && set.winner() == opponent:
+			games = append(games, 1)
+		case set.MatchTiebreak:
+			games = append(games, 0)
+		case opponent == 0:
+			games = append(games, set.Games1)
+		default:
+			games = append(games, set.Games2)
+		}
+	}
+	return games
+}
+
+func (s *Score) GetWinner() (int, error) {
+	setWins := 0
+	for _, set := range s.sets {
+		if set.winner() == 0 {
+			setWins += 1
+		} else {
+			setWins -= 1
+		}
+	}
+
+	if setWins > 0 {
+		return 0, nil
+	}
+	if setWins < 0 {
+		return 1, nil
+	}
+
+	return -1, ErrUndetermined
+}
+
+func (s *Score) Invert() core.Score {
+	sets := make([]Set, 0, len(s.sets))
+	for _, set := range s.sets {
+		sets = append(sets, set.invert())
+	}
+	return &Score{sets}
+}
+
+// Formats the score like 6-4 6-7(5) [10-8]
+func (s *Score) String() string {
+	sets := make([]string, 0, len(s.sets))
+	for _, set := range s.sets {
+		sets = append(sets, set.String())
+	}
+	return strings.Join(sets, " ")
+}
+
+// Creates a validated tennis score.
+//
+// A set is won with the set games and a margin of two games
+// or with one more game than the set games (e.g. 7-5).
+// At Games-Games the set is decided by a tiebreak (e.g. 7-6)
+// which has to be won with the tiebreak points and a margin
+// of two points.
+//
+// When the settings have a match tiebreak, the deciding set has
+// to be a match tiebreak without games. Its points are validated
+// like a tiebreak to the match tiebreak points.
+func NewScore(
+	sets []Set,
+	settings ScoreSettings,
+) (*Score, error) {
+	switch {
+	case len(sets) == 0:
+		return nil, ErrEmpty
+	case len(sets) < settings.WinningSets:
+		return nil, ErrTooFewSets
+	case len(sets) >= 2*settings.WinningSets:
+		return nil, ErrTooManySets
+	}
+
+	validated := make([]Set, 0, len(sets))
+	setWins := [2]int{}
+	for _, set := range sets {
+		if setWins[0] == settings.WinningSets || setWins[1] == settings.WinningSets {
+			return nil, ErrUnneededSets
+		}
+
+		decidingSet := setWins[0] == settings.WinningSets-1 && setWins[1] == settings.WinningSets-1
+		set.MatchTiebreak = decidingSet && settings.MatchTiebreakPoints > 0
+
+		var err error
+		if set.MatchTiebreak {
+			err = validateMatchTiebreak(set, settings)
+		} else {
+			err = validateSet(set, settings)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		setWins[set.winner()] += 1
+		validated = append(validated, set)
+	}
+
+	if setWins[0] == setWins[1] {
+		return nil, ErrEqualSetWins
+	}
+
+	return &Score{validated}, nil
+}
+
+func validateSet(set Set, settings ScoreSettings) error {
+	w := max(set.Games1, set.Games2)
+	l := min(set.Games1, set.Games2)
+	games := settings.Games
+	hasTiebreak := set.Tiebreak1 != 0 || set.Tiebreak2 != 0
+
+	switch {
+	case l < 0 || set.Tiebreak1 < 0 || set.Tiebreak2 < 0:
+		return ErrNegativePoints
+	case w == l:
+		return ErrUndeterminedSet
+	case w < games:
+		return ErrTooFewGames
+	case w > games+1:
+		return ErrTooManyGames
+	case w == games && w-l < 2:
+		fallthrough
+	case w == games+1 && l < games-1:
+		return ErrInvalidMargin
+	}
+
+	if w == games+1 && l == games {
+		tiebreakWinner := 0
+		if set.Tiebreak2 > set.Tiebreak1 {
+			tiebreakWinner = 1
+		}
+		if !hasTiebreak || tiebreakWinner != set.winner() {
+			return ErrInvalidTiebreak
+		}
+		return validateTiebreak(set.Tiebreak1, set.Tiebreak2, settings.TiebreakPoints)
+	}
+
+	if hasTiebreak {
+		return ErrUnexpectedTiebreak
+	}
+
+	return nil
+}
+
+func validateMatchTiebreak(set Set, settings ScoreSettings) error {
+	if set.Games1 != 0 || set.Games2 != 0 {
+		return ErrMatchTiebreakGames
+	}
+	if set.Tiebreak1 < 0 || set.Tiebreak2 < 0 {
+		return ErrNegativePoints
+	}
+	return validateTiebreak(set.Tiebreak1, set.Tiebreak2, settings.MatchTiebreakPoints)
+}
+
+// Validates that the tiebreak was won with the points and a
+// margin of two points (exactly two beyond the points)
+func validateTiebreak(a, b, points int) error {
+	w := max(a, b)
+	l := min(a, b)
+
+	switch {
+	case w < points:
+		return ErrInvalidTiebreak
+	case w-l < 2:
+		return ErrInvalidTiebreak
+	case w > points && w-l != 2:
+		return ErrInvalidTiebreak
+	}
+
+	return nil
+}
+
+// Returns the score of a match that the first opponent
+// won without losing a game (used for walkovers)
+func MaxScore(settings ScoreSettings) *Score {
+	sets := make([]Set, settings.WinningSets)
+	for i := range settings.WinningSets {
+		sets[i] = Set{Games1: settings.Games}
+	}
+	return &Score{sets}
+}
@@ -0,0 +1,175 @@
+package tennis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ezBadminton/gotournament/core"
+)
+
+type testPlayer struct {
+	id string
+}
+
+func (p *testPlayer) Id() string {
+	return p.id
+}
+
+func TestScoreSettings(t *testing.T) {
+	_, err := NewScoreSettings(0, 6, 7, 0)
+	if err != ErrSetsZero {
+		t.Fatal("zero sets did not error")
+	}
+
+	_, err = NewScoreSettings(2, 0, 7, 0)
+	if err != ErrGamesZero {
+		t.Fatal("zero games did not error")
+	}
+
+	_, err = NewScoreSettings(2, 6, 0, 10)
+	if err != ErrTiebreakZero {
+		t.Fatal("zero tiebreak points did not error")
+	}
+
+	settings, err := NewScoreSettings(2, 6, 7, 10)
+	if err != nil || settings != BestOf3MatchTiebreak {
+		t.Fatal("the match tiebreak settings did error")
+	}
+}
+
+func TestScoreErrors(t *testing.T) {
+	tests := []struct {
+		sets     []Set
+		settings ScoreSettings
+		err      error
+	}{
+		{[]Set{}, BestOf3, ErrEmpty},
+		{[]Set{{Games1: 6}}, BestOf3, ErrTooFewSets},
+		{[]Set{{Games1: 6}, {Games2: 6}, {Games1: 6}, {Games1: 6}}, BestOf3, ErrTooManySets},
+		{[]Set{{Games1: 6}, {Games1: 6}, {Games1: 6}}, BestOf3, ErrUnneededSets},
+		{[]Set{{Games1: 6, Games2: -1}, {Games1: 6}}, BestOf3, ErrNegativePoints},
+		{[]Set{{Games1: 6, Games2: 6}, {Games1: 6}}, BestOf3, ErrUndeterminedSet},
+		{[]Set{{Games1: 5, Games2: 3}, {Games1: 6}}, BestOf3, ErrTooFewGames},
+		{[]Set{{Games1: 8, Games2: 6}, {Games1: 6}}, BestOf3, ErrTooManyGames},
+		{[]Set{{Games1: 6, Games2: 5}, {Games1: 6}}, BestOf3, ErrInvalidMargin},
+		{[]Set{{Games1: 7, Games2: 4}, {Games1: 6}}, BestOf3, ErrInvalidMargin},
+		{[]Set{{Games1: 7, Games2: 6}, {Games1: 6}}, BestOf3, ErrInvalidTiebreak},
+		{[]Set{{Games1: 7, Games2: 6, Tiebreak1: 5, Tiebreak2: 7}, {Games1: 6}}, BestOf3, ErrInvalidTiebreak},
+		{[]Set{{Games1: 7, Games2: 6, Tiebreak1: 7, Tiebreak2: 6}, {Games1: 6}}, BestOf3, ErrInvalidTiebreak},
+		{[]Set{{Games1: 7, Games2: 6, Tiebreak1: 12, Tiebreak2: 6}, {Games1: 6}}, BestOf3, ErrInvalidTiebreak},
+		{[]Set{{Games1: 6, Games2: 4, Tiebreak1: 7}, {Games1: 6}}, BestOf3, ErrUnexpectedTiebreak},
+		{[]Set{{Games1: 6}, {Games2: 6}, {Games1: 6, Tiebreak1: 10}}, BestOf3MatchTiebreak, ErrMatchTiebreakGames},
+		{[]Set{{Games1: 6}, {Games2: 6}, {Tiebreak1: 10, Tiebreak2: 9}}, BestOf3MatchTiebreak, ErrInvalidTiebreak},
+		{[]Set{{Games1: 6}, {Games2: 6}}, BestOf3, ErrEqualSetWins},
+	}
+
+	for i, test := range tests {
+		_, err := NewScore(test.sets, test.settings)
+		if err != test.err {
+			t.Fatalf("test %d returned the wrong error: %v", i, err)
+		}
+	}
+}
+
+func TestValidScores(t *testing.T) {
+	sets := []Set{
+		{Games1: 6, Games2: 4},
+		{Games1: 6, Games2: 7, Tiebreak1: 5, Tiebreak2: 7},
+		{Tiebreak1: 12, Tiebreak2: 10},
+	}
+	score, err := NewScore(sets, BestOf3MatchTiebreak)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	winner, _ := score.GetWinner()
+	if winner != 0 || !score.Sets()[2].MatchTiebreak {
+		t.Fatal("the match tiebreak did not decide the match")
+	}
+
+	eq1 := reflect.DeepEqual(score.Points1(), []int{6, 6, 1})
+	eq2 := reflect.DeepEqual(score.Points2(), []int{4, 7, 0})
+	if !eq1 || !eq2 {
+		t.Fatal("the games of the score are incorrect")
+	}
+
+	inverted := score.Invert().(*Score)
+	winner, _ = inverted.GetWinner()
+	if winner != 1 || inverted.String() != "4-6 7-6(5) [10-12]" {
+		t.Fatal("the score was not inverted")
+	}
+
+	sets = []Set{
+		{Games1: 7, Games2: 5},
+		{Games1: 3, Games2: 6},
+		{Games1: 7, Games2: 6, Tiebreak1: 10, Tiebreak2: 8},
+	}
+	score, err = NewScore(sets, BestOf3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if score.Sets()[2].MatchTiebreak || score.String() != "7-5 3-6 7-6(8)" {
+		t.Fatal("the deciding set was not a regular set")
+	}
+}
+
+func TestMaxScore(t *testing.T) {
+	score := MaxScore(BestOf5)
+	if !reflect.DeepEqual(score.Points1(), []int{6, 6, 6}) || !reflect.DeepEqual(score.Points2(), []int{0, 0, 0}) {
+		t.Fatal("max score is incorrect")
+	}
+
+	_, err := NewScore(score.Sets(), BestOf5)
+	if err != nil {
+		t.Fatal("max score is invalid")
+	}
+}
+
+func TestScoreMetrics(t *testing.T) {
+	players := []core.Player{&testPlayer{"A"}, &testPlayer{"B"}, &testPlayer{"C"}}
+	tournament, _ := core.NewRoundRobin(core.NewConstantRanking(players), 1, MaxScore(BestOf3))
+
+	// Every player wins one match in straight sets. The games
+	// difference decides the ranking.
+	results := map[[2]core.Player][]Set{
+		{players[0], players[1]}: {{Games1: 6, Games2: 0}, {Games1: 6, Games2: 1}},
+		{players[1], players[2]}: {{Games1: 6, Games2: 4}, {Games1: 7, Games2: 5}},
+		{players[2], players[0]}: {{Games1: 7, Games2: 6, Tiebreak1: 7, Tiebreak2: 3}, {Games1: 6, Games2: 4}},
+	}
+
+	for _, m := range tournament.Matches {
+		if m.HasBye() {
+			continue
+		}
+		sets := results[[2]core.Player{m.Slot1.Player, m.Slot2.Player}]
+		inverted := sets == nil
+		if inverted {
+			sets = results[[2]core.Player{m.Slot2.Player, m.Slot1.Player}]
+		}
+
+		score, err := NewScore(sets, BestOf3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		m.StartMatch()
+		if inverted {
+			m.EndMatch(score.Invert())
+		} else {
+			m.EndMatch(score)
+		}
+	}
+	tournament.Update(nil)
+
+	metrics := tournament.FinalRanking.Metrics[players[0]]
+	eq1 := metrics.SetWins == 2 && metrics.SetLosses == 2
+	eq2 := metrics.PointWins == 22 && metrics.PointLosses == 14
+	if !eq1 || !eq2 {
+		t.Fatal("the sets and games were not counted in the metrics")
+	}
+
+	ranks := tournament.FinalRanking.Ranks()
+	eq1 = ranks[0].Player == players[0] && ranks[1].Player == players[2]
+	if !eq1 || ranks[2].Player != players[1] {
+		t.Fatal("the players were not ranked by their games difference")
+	}
+}